@@ -13,103 +13,103 @@ type Argument interface {
 
 // Nil argument is used to validate nil values of any nillable types.
 func Nil(isNil bool, constraints ...NilConstraint) ValidatorArgument {
-	return NewArgument(validateNil(isNil, constraints))
+	return NewArgument(validateNil(isNil, constraints)).describedAs(describeConstraints("", constraints))
 }
 
 // NilProperty argument is an alias for [Nil] that automatically adds property name to the current validation context.
 func NilProperty(name string, isNil bool, constraints ...NilConstraint) ValidatorArgument {
-	return NewArgument(validateNil(isNil, constraints)).At(PropertyName(name))
+	return NewArgument(validateNil(isNil, constraints)).describedAs(describeConstraints("", constraints)).At(PropertyName(name))
 }
 
 // Bool argument is used to validate boolean values.
 func Bool(value bool, constraints ...BoolConstraint) ValidatorArgument {
-	return NewArgument(validateBool(&value, constraints))
+	return NewArgument(validateBool(&value, constraints)).describedAs(describeConstraints("boolean", constraints))
 }
 
 // BoolProperty argument is an alias for [Bool] that automatically adds property name to the current validation context.
 func BoolProperty(name string, value bool, constraints ...BoolConstraint) ValidatorArgument {
-	return NewArgument(validateBool(&value, constraints)).At(PropertyName(name))
+	return NewArgument(validateBool(&value, constraints)).describedAs(describeConstraints("boolean", constraints)).At(PropertyName(name))
 }
 
 // NilBool argument is used to validate nillable boolean values.
 func NilBool(value *bool, constraints ...BoolConstraint) ValidatorArgument {
-	return NewArgument(validateBool(value, constraints))
+	return NewArgument(validateBool(value, constraints)).describedAs(describeConstraints("boolean", constraints))
 }
 
 // NilBoolProperty argument is an alias for [NilBool] that automatically adds property name to the current validation context.
 func NilBoolProperty(name string, value *bool, constraints ...BoolConstraint) ValidatorArgument {
-	return NewArgument(validateBool(value, constraints)).At(PropertyName(name))
+	return NewArgument(validateBool(value, constraints)).describedAs(describeConstraints("boolean", constraints)).At(PropertyName(name))
 }
 
 // Number argument is used to validate numbers.
 func Number[T Numeric](value T, constraints ...NumberConstraint[T]) ValidatorArgument {
-	return NewArgument(validateNumber(&value, constraints))
+	return NewArgument(validateNumber(&value, constraints)).describedAs(describeConstraints(jsonSchemaTypeOf[T](), constraints))
 }
 
 // NumberProperty argument is an alias for [Number] that automatically adds property name to the current validation context.
 func NumberProperty[T Numeric](name string, value T, constraints ...NumberConstraint[T]) ValidatorArgument {
-	return NewArgument(validateNumber(&value, constraints)).At(PropertyName(name))
+	return NewArgument(validateNumber(&value, constraints)).describedAs(describeConstraints(jsonSchemaTypeOf[T](), constraints)).At(PropertyName(name))
 }
 
 // NilNumber argument is used to validate nillable numbers.
 func NilNumber[T Numeric](value *T, constraints ...NumberConstraint[T]) ValidatorArgument {
-	return NewArgument(validateNumber(value, constraints))
+	return NewArgument(validateNumber(value, constraints)).describedAs(describeConstraints(jsonSchemaTypeOf[T](), constraints))
 }
 
 // NilNumberProperty argument is an alias for [NilNumber] that automatically adds property name to the current validation context.
 func NilNumberProperty[T Numeric](name string, value *T, constraints ...NumberConstraint[T]) ValidatorArgument {
-	return NewArgument(validateNumber(value, constraints)).At(PropertyName(name))
+	return NewArgument(validateNumber(value, constraints)).describedAs(describeConstraints(jsonSchemaTypeOf[T](), constraints)).At(PropertyName(name))
 }
 
 // String argument is used to validate strings.
 func String(value string, constraints ...StringConstraint) ValidatorArgument {
-	return NewArgument(validateString(&value, constraints))
+	return NewArgument(validateString(&value, constraints)).describedAs(describeConstraints("string", constraints))
 }
 
 // StringProperty argument is an alias for [String] that automatically adds property name to the current validation context.
 func StringProperty(name string, value string, constraints ...StringConstraint) ValidatorArgument {
-	return NewArgument(validateString(&value, constraints)).At(PropertyName(name))
+	return NewArgument(validateString(&value, constraints)).describedAs(describeConstraints("string", constraints)).At(PropertyName(name))
 }
 
 // NilString argument is used to validate nillable strings.
 func NilString(value *string, constraints ...StringConstraint) ValidatorArgument {
-	return NewArgument(validateString(value, constraints))
+	return NewArgument(validateString(value, constraints)).describedAs(describeConstraints("string", constraints))
 }
 
 // NilStringProperty argument is an alias for [NilString] that automatically adds property name to the current validation context.
 func NilStringProperty(name string, value *string, constraints ...StringConstraint) ValidatorArgument {
-	return NewArgument(validateString(value, constraints)).At(PropertyName(name))
+	return NewArgument(validateString(value, constraints)).describedAs(describeConstraints("string", constraints)).At(PropertyName(name))
 }
 
 // Countable argument can be used to validate size of an array, slice, or map. You can pass result of len()
 // function as an argument.
 func Countable(count int, constraints ...CountableConstraint) ValidatorArgument {
-	return NewArgument(validateCountable(count, constraints))
+	return NewArgument(validateCountable(count, constraints)).describedAs(describeConstraints("", constraints))
 }
 
 // CountableProperty argument is an alias for [Countable] that automatically adds property name to the current validation context.
 func CountableProperty(name string, count int, constraints ...CountableConstraint) ValidatorArgument {
-	return NewArgument(validateCountable(count, constraints)).At(PropertyName(name))
+	return NewArgument(validateCountable(count, constraints)).describedAs(describeConstraints("", constraints)).At(PropertyName(name))
 }
 
 // Time argument is used to validate [time.Time] value.
 func Time(value time.Time, constraints ...TimeConstraint) ValidatorArgument {
-	return NewArgument(validateTime(&value, constraints))
+	return NewArgument(validateTime(&value, constraints)).describedAs(describeFormatted("string", "date-time", constraints))
 }
 
 // TimeProperty argument is an alias for [Time] that automatically adds property name to the current validation context.
 func TimeProperty(name string, value time.Time, constraints ...TimeConstraint) ValidatorArgument {
-	return NewArgument(validateTime(&value, constraints)).At(PropertyName(name))
+	return NewArgument(validateTime(&value, constraints)).describedAs(describeFormatted("string", "date-time", constraints)).At(PropertyName(name))
 }
 
 // NilTime argument is used to validate nillable [time.Time] value.
 func NilTime(value *time.Time, constraints ...TimeConstraint) ValidatorArgument {
-	return NewArgument(validateTime(value, constraints))
+	return NewArgument(validateTime(value, constraints)).describedAs(describeFormatted("string", "date-time", constraints))
 }
 
 // NilTimeProperty argument is an alias for [NilTime] that automatically adds property name to the current validation context.
 func NilTimeProperty(name string, value *time.Time, constraints ...TimeConstraint) ValidatorArgument {
-	return NewArgument(validateTime(value, constraints)).At(PropertyName(name))
+	return NewArgument(validateTime(value, constraints)).describedAs(describeFormatted("string", "date-time", constraints)).At(PropertyName(name))
 }
 
 // Valid is used to run validation on the [Validatable] type. This method is recommended
@@ -134,6 +134,19 @@ func ValidSliceProperty[T Validatable](name string, values []T) ValidatorArgumen
 	return NewArgument(validateSlice(values)).At(PropertyName(name))
 }
 
+// ValidSliceParallel is an alias for [ValidSlice] that validates the elements of the slice in parallel
+// by the pool of workers. If the number of workers is zero or negative, then [runtime.GOMAXPROCS] is used.
+// Violations are returned in the order of the elements. If the context is canceled, then the validation
+// process is terminated with the context error. See [EachParallel] for details.
+func ValidSliceParallel[T Validatable](values []T, workers int) ValidatorArgument {
+	return NewArgument(validateSliceParallel(values, workers))
+}
+
+// ValidSliceParallelProperty argument is an alias for [ValidSliceParallel] that automatically adds property name to the current validation context.
+func ValidSliceParallelProperty[T Validatable](name string, values []T, workers int) ValidatorArgument {
+	return NewArgument(validateSliceParallel(values, workers)).At(PropertyName(name))
+}
+
 // ValidMap is a generic argument used to run validation on the map of [Validatable] types.
 // This method is recommended to build a complex validation process.
 func ValidMap[T Validatable](values map[string]T) ValidatorArgument {
@@ -147,37 +160,37 @@ func ValidMapProperty[T Validatable](name string, values map[string]T) Validator
 
 // Comparable argument is used to validate generic comparable value.
 func Comparable[T comparable](value T, constraints ...ComparableConstraint[T]) ValidatorArgument {
-	return NewArgument(validateComparable(&value, constraints))
+	return NewArgument(validateComparable(&value, constraints)).describedAs(describeConstraints(jsonSchemaTypeOf[T](), constraints))
 }
 
 // ComparableProperty argument is an alias for [Comparable] that automatically adds property name to the current validation context.
 func ComparableProperty[T comparable](name string, value T, constraints ...ComparableConstraint[T]) ValidatorArgument {
-	return NewArgument(validateComparable(&value, constraints)).At(PropertyName(name))
+	return NewArgument(validateComparable(&value, constraints)).describedAs(describeConstraints(jsonSchemaTypeOf[T](), constraints)).At(PropertyName(name))
 }
 
 // NilComparable argument is used to validate nillable generic comparable value.
 func NilComparable[T comparable](value *T, constraints ...ComparableConstraint[T]) ValidatorArgument {
-	return NewArgument(validateComparable(value, constraints))
+	return NewArgument(validateComparable(value, constraints)).describedAs(describeConstraints(jsonSchemaTypeOf[T](), constraints))
 }
 
 // NilComparableProperty argument is an alias for [NilComparable] that automatically adds property name to the current validation context.
 func NilComparableProperty[T comparable](name string, value *T, constraints ...ComparableConstraint[T]) ValidatorArgument {
-	return NewArgument(validateComparable(value, constraints)).At(PropertyName(name))
+	return NewArgument(validateComparable(value, constraints)).describedAs(describeConstraints(jsonSchemaTypeOf[T](), constraints)).At(PropertyName(name))
 }
 
 // Comparables argument is used to validate generic comparable types.
 func Comparables[T comparable](values []T, constraints ...ComparablesConstraint[T]) ValidatorArgument {
-	return NewArgument(validateComparables(values, constraints))
+	return NewArgument(validateComparables(values, constraints)).describedAs(describeConstraints("array", constraints))
 }
 
 // ComparablesProperty argument is an alias for [Comparables] that automatically adds property name to the current validation context.
 func ComparablesProperty[T comparable](name string, values []T, constraints ...ComparablesConstraint[T]) ValidatorArgument {
-	return NewArgument(validateComparables(values, constraints)).At(PropertyName(name))
+	return NewArgument(validateComparables(values, constraints)).describedAs(describeConstraints("array", constraints)).At(PropertyName(name))
 }
 
 // Slice argument is used to validate a generic slice with [SliceConstraint] list.
 func Slice[T any](value []T, constraints ...SliceConstraint[T]) ValidatorArgument {
-	return NewArgument(validateSliceWithConstraints(value, constraints))
+	return NewArgument(validateSliceWithConstraints(value, constraints)).describedAs(describeConstraints("array", constraints))
 }
 
 // SliceProperty argument is an alias for [Slice] that automatically adds property name to the current validation context.
@@ -187,38 +200,38 @@ func SliceProperty[T any](name string, value []T, constraints ...SliceConstraint
 
 // EachString is used to validate a slice of strings.
 func EachString(values []string, constraints ...StringConstraint) ValidatorArgument {
-	return NewArgument(validateEachString(values, constraints))
+	return NewArgument(validateEachString(values, constraints)).describedAs(describeItems("string", constraints))
 }
 
 // EachStringProperty argument is an alias for [EachString] that automatically adds property name to the current validation context.
 func EachStringProperty(name string, values []string, constraints ...StringConstraint) ValidatorArgument {
-	return NewArgument(validateEachString(values, constraints)).At(PropertyName(name))
+	return NewArgument(validateEachString(values, constraints)).describedAs(describeItems("string", constraints)).At(PropertyName(name))
 }
 
 // EachNumber is used to validate a slice of numbers.
 func EachNumber[T Numeric](values []T, constraints ...NumberConstraint[T]) ValidatorArgument {
-	return NewArgument(validateEachNumber(values, constraints))
+	return NewArgument(validateEachNumber(values, constraints)).describedAs(describeItems(jsonSchemaTypeOf[T](), constraints))
 }
 
 // EachNumberProperty argument is an alias for [EachNumber] that automatically adds property name to the current validation context.
 func EachNumberProperty[T Numeric](name string, values []T, constraints ...NumberConstraint[T]) ValidatorArgument {
-	return NewArgument(validateEachNumber(values, constraints)).At(PropertyName(name))
+	return NewArgument(validateEachNumber(values, constraints)).describedAs(describeItems(jsonSchemaTypeOf[T](), constraints)).At(PropertyName(name))
 }
 
 // EachComparable is used to validate a slice of generic comparables.
 func EachComparable[T comparable](values []T, constraints ...ComparableConstraint[T]) ValidatorArgument {
-	return NewArgument(validateEachComparable(values, constraints))
+	return NewArgument(validateEachComparable(values, constraints)).describedAs(describeItems(jsonSchemaTypeOf[T](), constraints))
 }
 
 // EachComparableProperty argument is an alias for [EachComparable] that automatically adds property name to the current validation context.
 func EachComparableProperty[T comparable](name string, values []T, constraints ...ComparableConstraint[T]) ValidatorArgument {
-	return NewArgument(validateEachComparable(values, constraints)).At(PropertyName(name))
+	return NewArgument(validateEachComparable(values, constraints)).describedAs(describeItems(jsonSchemaTypeOf[T](), constraints)).At(PropertyName(name))
 }
 
 // Each validates each element of the slice with the given [Constraint][E] list.
 // Violation paths include the element index (e.g. [0], [1]). Use [EachProperty] to add a property name to the path.
 func Each[E any](items []E, constraints ...Constraint[E]) ValidatorArgument {
-	return NewArgument(validateEach(items, constraints))
+	return NewArgument(validateEach(items, constraints)).describedAs(describeItems(jsonSchemaTypeOf[E](), constraints))
 }
 
 // EachProperty is an alias for [Each] that adds the property name to the violation path (e.g. items[0], items[1]).
@@ -226,6 +239,25 @@ func EachProperty[E any](name string, items []E, constraints ...Constraint[E]) V
 	return Each(items, constraints...).At(PropertyName(name))
 }
 
+// EachParallel is an alias for [Each] that validates the elements of the slice in parallel by the pool of workers.
+// It is useful for large slices (for example, batch imports) and constraints that are expensive to check.
+// If the number of workers is zero or negative, then [runtime.GOMAXPROCS] is used.
+//
+// Elements are split into contiguous chunks distributed among the workers (see [Async]),
+// so violations are returned in the order of the elements and their paths contain the element index.
+// Constraints must be safe for concurrent use. If the context is canceled, then the validation
+// process is terminated with the context error. If a constraint returns an error that is not a violation
+// or panics, then the other workers are stopped and the validation process is terminated with this error.
+func EachParallel[E any](items []E, workers int, constraints ...Constraint[E]) ValidatorArgument {
+	return NewArgument(validateEachParallel(items, workers, constraints)).
+		describedAs(describeItems(jsonSchemaTypeOf[E](), constraints))
+}
+
+// EachParallelProperty is an alias for [EachParallel] that adds the property name to the violation path (e.g. items[0], items[1]).
+func EachParallelProperty[E any](name string, items []E, workers int, constraints ...Constraint[E]) ValidatorArgument {
+	return EachParallel(items, workers, constraints...).At(PropertyName(name))
+}
+
 // CheckNoViolations is a special argument that checks errs for violations. If an error contains [Violation] or [ViolationList]
 // then these violations will be appended into returned violation list from the validator. If an error
 // does not implement [Violation] or [ViolationList], then the validation process will be terminated and
@@ -286,9 +318,11 @@ func This[T any](v T, constraints ...Constraint[T]) ValidatorArgument {
 // ValidatorArgument is common implementation of [Argument] that is used to run validation
 // process on given argument.
 type ValidatorArgument struct {
-	isIgnored bool
-	validate  ValidateFunc
-	path      []PropertyPathElement
+	isIgnored   bool
+	isSensitive bool
+	validate    ValidateFunc
+	describe    describeFunc
+	path        []PropertyPathElement
 }
 
 // At returns a copy of [ValidatorArgument] with appended property path suffix.
@@ -304,10 +338,36 @@ func (arg ValidatorArgument) When(condition bool) ValidatorArgument {
 	return arg
 }
 
+// Sensitive marks the argument as containing a secret (for example, a password or a card number).
+// All template parameters of the violations created during the validation of the argument
+// are marked as sensitive, so their values are masked (see [TemplateParameter.Sensitive]).
+func (arg ValidatorArgument) Sensitive() ValidatorArgument {
+	arg.isSensitive = true
+	return arg
+}
+
 func (arg ValidatorArgument) setUp(ctx *executionContext) {
 	if !arg.isIgnored {
-		ctx.addValidation(arg.validate, arg.path...)
+		ctx.addValidation(arg.run, arg.path...)
+	}
+}
+
+func (arg ValidatorArgument) describedAs(describe describeFunc) ValidatorArgument {
+	arg.describe = describe
+	return arg
+}
+
+func (arg ValidatorArgument) run(ctx context.Context, validator *Validator) (*ViolationList, error) {
+	if validator.schema != nil && arg.describe != nil {
+		validator.schema.describe(validator.propertyPath, arg.describe())
+		return nil, nil
+	}
+	if arg.isSensitive && !validator.isSensitive {
+		validator = validator.copy()
+		validator.isSensitive = true
 	}
+
+	return arg.validate(ctx, validator)
 }
 
 // Checker is an argument that can be useful for quickly checking the result of
@@ -320,6 +380,8 @@ type Checker struct {
 	err               error
 	messageTemplate   string
 	messageParameters TemplateParameterList
+	severity          Severity
+	payload           any
 }
 
 // At returns a copy of [Checker] with appended property path suffix.
@@ -355,6 +417,20 @@ func (c Checker) WithMessage(template string, parameters ...TemplateParameter) C
 	return c
 }
 
+// WithSeverity sets the level of the produced violation (see [Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c Checker) WithSeverity(severity Severity) Checker {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c Checker) WithPayload(payload any) Checker {
+	c.payload = payload
+	return c
+}
+
 func (c Checker) setUp(arguments *executionContext) {
 	arguments.addValidation(c.validate, c.path...)
 }
@@ -365,6 +441,8 @@ func (c Checker) validate(ctx context.Context, validator *Validator) (*Violation
 	}
 
 	violation := validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithParameters(c.messageParameters...).
 		Create()
 
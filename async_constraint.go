@@ -0,0 +1,297 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// AsyncLoader is used by the [AsyncConstraint] to check the values by the external source,
+// for example, by one query to the database. The loader receives the batch of unique non-empty values
+// and returns the result of the check for each value: true means that the value is valid.
+// Values missing in the result are considered invalid.
+type AsyncLoader[T comparable] interface {
+	Load(ctx context.Context, values []T) (map[T]bool, error)
+}
+
+// AsyncLoaderFunc is an adapter that allows you to use ordinary functions as an [AsyncLoader].
+type AsyncLoaderFunc[T comparable] func(ctx context.Context, values []T) (map[T]bool, error)
+
+// Load calls the underlying function and implements [AsyncLoader].
+func (f AsyncLoaderFunc[T]) Load(ctx context.Context, values []T) (map[T]bool, error) {
+	return f(ctx, values)
+}
+
+// asyncLoaderKey identifies the cache of the loader within the validation run.
+// It has non-zero size, so pointers to the different keys are not equal.
+type asyncLoaderKey struct {
+	_ byte
+}
+
+// AsyncConstraint is used to check the values by I/O operations, for example, to check that
+// the email is not registered yet or that the referenced entity exists. The values are checked
+// by the [AsyncLoader] with the context of the validation process.
+//
+// The results of the loader are cached within the single call of the [Validator.Validate] method,
+// so the same value is loaded only once, even if it is validated in the different places.
+// When the constraint is used with [Each], [EachProperty], [EachParallel], [EachComparable],
+// or [Slice] arguments, then all the elements are loaded by the single call of the loader.
+//
+// Empty values (zero values of the type) are ignored. Errors of the loader stop the validation process
+// and are returned as the result of the validation.
+type AsyncConstraint[T comparable] struct {
+	key               *asyncLoaderKey
+	loader            AsyncLoader[T]
+	timeout           time.Duration
+	isIgnored         bool
+	groups            []string
+	err               error
+	messageTemplate   string
+	messageParameters TemplateParameterList
+	severity          Severity
+	payload           any
+}
+
+// NewAsyncConstraint creates the [AsyncConstraint] checking the values by the loader.
+// The constraint instance holds the cache key, so create it once and reuse it in different places
+// to share the cached results of the loader.
+func NewAsyncConstraint[T comparable](loader AsyncLoader[T]) AsyncConstraint[T] {
+	return AsyncConstraint[T]{
+		key:             &asyncLoaderKey{},
+		loader:          loader,
+		err:             ErrNotValid,
+		messageTemplate: ErrNotValid.Message(),
+	}
+}
+
+// WithTimeout sets the timeout for the single call of the loader. If the loader does not respond in time,
+// then the validation process is stopped with the error wrapping [context.DeadlineExceeded].
+func (c AsyncConstraint[T]) WithTimeout(timeout time.Duration) AsyncConstraint[T] {
+	c.timeout = timeout
+	return c
+}
+
+// WithError overrides default error for produced violation.
+func (c AsyncConstraint[T]) WithError(err error) AsyncConstraint[T] {
+	c.err = err
+	return c
+}
+
+// WithMessage sets the violation message template. You can set custom template parameters
+// for injecting its values into the final message. Also, you can use default parameters:
+//
+//	{{ value }} - the current (invalid) value.
+func (c AsyncConstraint[T]) WithMessage(template string, parameters ...TemplateParameter) AsyncConstraint[T] {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+	return c
+}
+
+// When enables conditional validation of this constraint. If the expression evaluates to false,
+// then the constraint will be ignored.
+func (c AsyncConstraint[T]) When(condition bool) AsyncConstraint[T] {
+	c.isIgnored = !condition
+	return c
+}
+
+// WhenGroups enables conditional validation of the constraint by using the validation groups.
+func (c AsyncConstraint[T]) WhenGroups(groups ...string) AsyncConstraint[T] {
+	c.groups = groups
+	return c
+}
+
+// WithSeverity sets the level of the produced violation (see [Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c AsyncConstraint[T]) WithSeverity(severity Severity) AsyncConstraint[T] {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c AsyncConstraint[T]) WithPayload(payload any) AsyncConstraint[T] {
+	c.payload = payload
+	return c
+}
+
+// Validate implements [Constraint][T] so the constraint can be used with [This] and [Each].
+func (c AsyncConstraint[T]) Validate(ctx context.Context, validator *Validator, v T) error {
+	var zero T
+	if c.isIgnoredBy(validator) || v == zero {
+		return nil
+	}
+
+	results, err := c.load(ctx, validator, []T{v})
+	if err != nil {
+		return err
+	}
+	if results[v] {
+		return nil
+	}
+
+	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
+		WithParameters(c.messageParameters.Prepend(TemplateParameter{Key: "{{ value }}", Value: formatAsyncValue(v)})...).
+		Create()
+}
+
+// ValidateComparable implements [ComparableConstraint][T].
+func (c AsyncConstraint[T]) ValidateComparable(ctx context.Context, validator *Validator, value *T) error {
+	if value == nil {
+		return nil
+	}
+
+	return c.Validate(ctx, validator, *value)
+}
+
+// ValidateSlice implements [SliceConstraint][T]. It loads all the elements by the single call
+// of the loader and creates violations at the indexes of the invalid elements.
+func (c AsyncConstraint[T]) ValidateSlice(ctx context.Context, validator *Validator, items []T) error {
+	if c.isIgnoredBy(validator) {
+		return nil
+	}
+
+	results, err := c.load(ctx, validator, items)
+	if err != nil {
+		return err
+	}
+
+	violations := validator.BuildViolationList(ctx)
+	var zero T
+	for i, item := range items {
+		if item == zero || results[item] {
+			continue
+		}
+		violations.BuildViolation(c.err, c.messageTemplate).
+			WithSeverity(c.severity).
+			WithPayload(c.payload).
+			WithParameters(c.messageParameters.Prepend(TemplateParameter{Key: "{{ value }}", Value: formatAsyncValue(item)})...).
+			AtIndex(i).
+			Add()
+	}
+
+	return violations.Create().asError()
+}
+
+// loadBatch loads all the elements into the cache of the validation run before they are validated one by one.
+func (c AsyncConstraint[T]) loadBatch(ctx context.Context, validator *Validator, items []T) error {
+	if c.isIgnoredBy(validator) {
+		return nil
+	}
+
+	_, err := c.load(ctx, validator, items)
+
+	return err
+}
+
+// batchLoader is implemented by the constraints that can load all the elements
+// before they are validated one by one (see [AsyncConstraint]).
+type batchLoader[T any] interface {
+	loadBatch(ctx context.Context, validator *Validator, items []T) error
+}
+
+func loadBatches[T, C any](ctx context.Context, validator *Validator, items []T, constraints []C) error {
+	for _, constraint := range constraints {
+		if loader, ok := any(constraint).(batchLoader[T]); ok {
+			if err := loader.loadBatch(ctx, validator, items); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c AsyncConstraint[T]) isIgnoredBy(validator *Validator) bool {
+	return c.isIgnored || validator.IsIgnoredForGroups(c.groups...)
+}
+
+func (c AsyncConstraint[T]) load(ctx context.Context, validator *Validator, values []T) (map[T]bool, error) {
+	var cache *asyncCache[T]
+	if validator.run != nil {
+		cache, _ = validator.run.cache(c.key, func() any {
+			return &asyncCache[T]{results: make(map[T]bool)}
+		}).(*asyncCache[T])
+	}
+
+	results := make(map[T]bool, len(values))
+	missing := cache.get(values, results)
+	if len(missing) == 0 {
+		return results, nil
+	}
+
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+	loaded, err := c.loader.Load(ctx, missing)
+	if err != nil {
+		return nil, fmt.Errorf("load values of async constraint: %w", err)
+	}
+	for _, value := range missing {
+		results[value] = loaded[value]
+	}
+	cache.put(missing, results)
+
+	return results, nil
+}
+
+// asyncCache holds the results of the loader within the validation run.
+type asyncCache[T comparable] struct {
+	mu      sync.RWMutex
+	results map[T]bool
+}
+
+// get copies the cached results of the values and returns the unique non-empty values missing in the cache.
+func (cache *asyncCache[T]) get(values []T, results map[T]bool) []T {
+	if cache != nil {
+		cache.mu.RLock()
+		defer cache.mu.RUnlock()
+	}
+
+	var zero T
+	missing := make([]T, 0, len(values))
+	for _, value := range values {
+		if value == zero {
+			continue
+		}
+		if _, ok := results[value]; ok {
+			continue
+		}
+		if cache != nil {
+			if result, ok := cache.results[value]; ok {
+				results[value] = result
+				continue
+			}
+		}
+		// the value is marked as invalid until it is loaded to skip duplicates
+		results[value] = false
+		missing = append(missing, value)
+	}
+
+	return missing
+}
+
+func (cache *asyncCache[T]) put(values []T, results map[T]bool) {
+	if cache == nil {
+		return
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	for _, value := range values {
+		cache.results[value] = results[value]
+	}
+}
+
+func formatAsyncValue[T comparable](value T) string {
+	if s, ok := any(value).(string); ok {
+		return `"` + s + `"`
+	}
+
+	return fmt.Sprint(value)
+}
@@ -81,6 +81,9 @@ type StringFuncConstraint struct {
 	err               error
 	messageTemplate   string
 	messageParameters TemplateParameterList
+	description       ConstraintDescription
+	severity          Severity
+	payload           any
 }
 
 // OfStringBy creates a new string constraint from a function with signature func(string) bool.
@@ -121,12 +124,44 @@ func (c StringFuncConstraint) WhenGroups(groups ...string) StringFuncConstraint
 	return c
 }
 
+// WithSeverity sets the level of the produced violation (see [Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c StringFuncConstraint) WithSeverity(severity Severity) StringFuncConstraint {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c StringFuncConstraint) WithPayload(payload any) StringFuncConstraint {
+	c.payload = payload
+	return c
+}
+
+// WithDescription sets the describable form of the constraint (see [DescribableConstraint]).
+// For example, it can be used to set the format of the string.
+func (c StringFuncConstraint) WithDescription(description ConstraintDescription) StringFuncConstraint {
+	c.description = description
+	return c
+}
+
+// Describe returns the describable form of the constraint set by [StringFuncConstraint.WithDescription].
+func (c StringFuncConstraint) Describe() ConstraintDescription {
+	if c.isIgnored {
+		return ConstraintDescription{}
+	}
+
+	return c.description
+}
+
 func (c StringFuncConstraint) ValidateString(ctx context.Context, validator *Validator, value *string) error {
 	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" || c.isValid(*value) {
 		return nil
 	}
 
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithParameters(
 			c.messageParameters.Prepend(
 				TemplateParameter{Key: "{{ value }}", Value: *value},
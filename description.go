@@ -0,0 +1,121 @@
+package validation
+
+import (
+	"reflect"
+)
+
+// ConstraintDescription is a describable form of a constraint. It contains restrictions
+// checked by the constraint in terms of JSON Schema keywords, so it can be used by tooling
+// to introspect validation rules. For example, it is used to generate JSON Schema documents
+// by the [Validator.DescribeJSONSchema] method.
+//
+// Only restrictions that can be expressed by the keywords are described. Empty values mean
+// that the restriction is not checked by the constraint.
+type ConstraintDescription struct {
+	// Required is true if the constraint checks that the value is present (not blank or not nil).
+	Required bool
+	// Format of the string value (for example, "email", "uri", "uuid", "date-time").
+	Format string
+	// Pattern is a regular expression that the string value should match.
+	Pattern string
+	// MinLength and MaxLength are limits of the string length.
+	MinLength *int
+	MaxLength *int
+	// Minimum, Maximum, ExclusiveMinimum, and ExclusiveMaximum are limits of the numeric value.
+	Minimum          any
+	Maximum          any
+	ExclusiveMinimum any
+	ExclusiveMaximum any
+	// MultipleOf is a divisor of the numeric value.
+	MultipleOf any
+	// Enum is a list of allowed values.
+	Enum []any
+	// MinItems and MaxItems are limits of the elements count.
+	MinItems *int
+	MaxItems *int
+	// UniqueItems is true if all elements should be unique.
+	UniqueItems bool
+}
+
+// DescribableConstraint is implemented by constraints that are able to describe their restrictions
+// in the form of [ConstraintDescription]. Constraints that do not implement this interface
+// are skipped while describing the validation rules.
+type DescribableConstraint interface {
+	Describe() ConstraintDescription
+}
+
+// argumentDescription holds constraints of the validation argument to describe them without validation.
+type argumentDescription struct {
+	valueType   string
+	format      string
+	isItems     bool
+	constraints []ConstraintDescription
+}
+
+func newDescription[C any](valueType string, constraints []C) *argumentDescription {
+	description := &argumentDescription{valueType: valueType}
+	for _, constraint := range constraints {
+		if c, ok := any(constraint).(DescribableConstraint); ok {
+			description.constraints = append(description.constraints, c.Describe())
+		}
+	}
+
+	return description
+}
+
+// describeFunc builds the description of the argument. It is called only while describing
+// the validation rules, so the constraints are not described on ordinary validation.
+type describeFunc func() *argumentDescription
+
+func describeConstraints[C any](valueType string, constraints []C) describeFunc {
+	return func() *argumentDescription {
+		return newDescription(valueType, constraints)
+	}
+}
+
+func describeFormatted[C any](valueType, format string, constraints []C) describeFunc {
+	return func() *argumentDescription {
+		description := newDescription(valueType, constraints)
+		description.format = format
+
+		return description
+	}
+}
+
+func describeItems[C any](valueType string, constraints []C) describeFunc {
+	return func() *argumentDescription {
+		description := newDescription(valueType, constraints)
+		description.isItems = true
+
+		return description
+	}
+}
+
+// jsonSchemaTypeOf returns a JSON Schema type for the Go type.
+func jsonSchemaTypeOf[T any]() string {
+	t := reflect.TypeFor[T]()
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == timeReflectType {
+		return "string"
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Array, reflect.Slice:
+		return "array"
+	case reflect.Map, reflect.Struct:
+		return "object"
+	default:
+		return ""
+	}
+}
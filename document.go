@@ -0,0 +1,383 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Document argument is used to validate dynamic documents, for example, map[string]any decoded from JSON.
+// Values of the document are selected by the fields created by [SelectString], [SelectNumber],
+// [SelectBool], [SelectTime], and [SelectCountable].
+//
+// Selectors are property paths in the format supported by [PropertyPath.UnmarshalText] with an additional
+// "[*]" element that matches every element of the array or every value of the map (in the order
+// of the keys). For example, "items[*].price" selects the prices of all items.
+// Violations are created at the concrete resolved path (for example, "items[1].price").
+//
+// Missing values and null values are passed to the constraints as nil, so only the constraints
+// checking for nil (for example, it.IsNotBlank) are applied to them. A wildcard over a missing value
+// does not select anything. If the document has a value of an unexpected type on the path
+// (for example, a string instead of an object), then the violation with [ErrInvalidType] is created at it.
+// If the selector cannot be parsed, then the validation process will be terminated with [ConstraintError].
+func Document(document map[string]any, fields ...DocumentField) ValidatorArgument {
+	return NewArgument(validateDocument(document, fields))
+}
+
+// DocumentProperty argument is an alias for [Document] that automatically adds property name to the current validation context.
+func DocumentProperty(name string, document map[string]any, fields ...DocumentField) ValidatorArgument {
+	return NewArgument(validateDocument(document, fields)).At(PropertyName(name))
+}
+
+// DocumentField is used to select values from the document to validate them by the constraints
+// (see [Document]). Selected values are coerced into the type of the constraints.
+type DocumentField struct {
+	selector string
+	path     *PropertyPath
+	err      error
+	validate documentValueFunc
+}
+
+type documentValueFunc func(ctx context.Context, validator *Validator, value reflect.Value) (*ViolationList, error)
+
+func newDocumentField(selector string, validate documentValueFunc) DocumentField {
+	parser := pathParser{allowWildcard: true}
+	path, err := parser.Parse(selector)
+
+	return DocumentField{selector: selector, path: path, err: err, validate: validate}
+}
+
+// SelectString selects values of the document to validate them by the string constraints.
+// Numbers and booleans are converted into strings, values of other types produce the violation
+// with [ErrInvalidType].
+func SelectString(selector string, constraints ...StringConstraint) DocumentField {
+	return newDocumentField(selector, func(ctx context.Context, validator *Validator, v reflect.Value) (*ViolationList, error) {
+		value, ok := coerceString(v)
+		if !ok {
+			return nil, newInvalidTypeViolation(ctx, validator, "string")
+		}
+
+		return validateString(value, constraints)(ctx, validator)
+	})
+}
+
+// SelectNumber selects values of the document to validate them by the number constraints.
+// Numbers and strings are converted into the type T if it is possible without loss of precision
+// (for example, 12.5 cannot be converted into an integer). Other values produce the violation
+// with [ErrInvalidType].
+func SelectNumber[T Numeric](selector string, constraints ...NumberConstraint[T]) DocumentField {
+	return newDocumentField(selector, func(ctx context.Context, validator *Validator, v reflect.Value) (*ViolationList, error) {
+		value, ok := coerceNumber[T](v)
+		if !ok {
+			return nil, newInvalidTypeViolation(ctx, validator, jsonSchemaTypeOf[T]())
+		}
+
+		return validateNumber(value, constraints)(ctx, validator)
+	})
+}
+
+// SelectBool selects values of the document to validate them by the boolean constraints.
+// Strings are converted by [strconv.ParseBool], other values produce the violation with [ErrInvalidType].
+func SelectBool(selector string, constraints ...BoolConstraint) DocumentField {
+	return newDocumentField(selector, func(ctx context.Context, validator *Validator, v reflect.Value) (*ViolationList, error) {
+		value, ok := coerceBool(v)
+		if !ok {
+			return nil, newInvalidTypeViolation(ctx, validator, "boolean")
+		}
+
+		return validateBool(value, constraints)(ctx, validator)
+	})
+}
+
+// SelectTime selects values of the document to validate them by the time constraints.
+// Strings are parsed by the layout ([time.RFC3339] is used if the layout is empty).
+// Strings that cannot be parsed produce the violation with [ErrInvalidDateTime],
+// other values except [time.Time] produce the violation with [ErrInvalidType].
+func SelectTime(selector string, layout string, constraints ...TimeConstraint) DocumentField {
+	if layout == "" {
+		layout = time.RFC3339
+	}
+
+	return newDocumentField(selector, func(ctx context.Context, validator *Validator, v reflect.Value) (*ViolationList, error) {
+		value, err := coerceTime(v, layout)
+		if errors.Is(err, ErrInvalidType) {
+			return nil, newInvalidTypeViolation(ctx, validator, "string")
+		}
+		if err != nil {
+			return nil, validator.BuildViolation(ctx, err, ErrInvalidDateTime.Message()).Create()
+		}
+
+		return validateTime(value, constraints)(ctx, validator)
+	})
+}
+
+// SelectCountable selects arrays and objects of the document to validate their
+// number of elements by the countable constraints. Missing values are counted as empty.
+// Values of other types produce the violation with [ErrInvalidType].
+func SelectCountable(selector string, constraints ...CountableConstraint) DocumentField {
+	return newDocumentField(selector, func(ctx context.Context, validator *Validator, v reflect.Value) (*ViolationList, error) {
+		count := 0
+		if v.IsValid() {
+			if v.Kind() != reflect.Slice && v.Kind() != reflect.Array && v.Kind() != reflect.Map {
+				return nil, newInvalidTypeViolation(ctx, validator, "array")
+			}
+			count = v.Len()
+		}
+
+		return validateCountable(count, constraints)(ctx, validator)
+	})
+}
+
+func validateDocument(document map[string]any, fields []DocumentField) ValidateFunc {
+	return func(ctx context.Context, validator *Validator) (*ViolationList, error) {
+		resolver := &documentResolver{
+			violations: NewViolationList(),
+			mismatches: make(map[string]bool),
+		}
+
+		for i := range fields {
+			if fields[i].err != nil {
+				return nil, validator.CreateConstraintError(
+					"Document",
+					fmt.Sprintf(`invalid selector "%s": %s`, fields[i].selector, fields[i].err.Error()),
+				)
+			}
+			err := resolver.resolve(ctx, validator, reflect.ValueOf(document), fields[i].path.Elements(), &fields[i])
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return resolver.violations, nil
+	}
+}
+
+type documentResolver struct {
+	violations *ViolationList
+	// mismatches holds the paths of values of unexpected type to report them only once
+	mismatches map[string]bool
+}
+
+func (resolver *documentResolver) resolve(
+	ctx context.Context,
+	validator *Validator,
+	v reflect.Value,
+	elements []PropertyPathElement,
+	field *DocumentField,
+) error {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			v = reflect.Value{}
+			break
+		}
+		v = v.Elem()
+	}
+	if len(elements) == 0 {
+		if validator.isLimitReached() {
+			return nil
+		}
+		violations, err := field.validate(ctx, validator, v)
+		if err != nil {
+			return resolver.violations.AppendFromError(err)
+		}
+		resolver.violations.Join(violations)
+		return nil
+	}
+	if !v.IsValid() {
+		if slices.ContainsFunc(elements, isWildcard) {
+			return nil
+		}
+		return resolver.resolve(ctx, validator.At(elements...), v, nil, field)
+	}
+
+	switch element := elements[0].(type) {
+	case wildcard:
+		return resolver.resolveEach(ctx, validator, v, elements[1:], field)
+	case ArrayIndex:
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			return resolver.addMismatch(ctx, validator, "array")
+		}
+		next := reflect.Value{}
+		if int(element) < v.Len() {
+			next = v.Index(int(element))
+		}
+		return resolver.resolve(ctx, validator.At(element), next, elements[1:], field)
+	default:
+		if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String {
+			return resolver.addMismatch(ctx, validator, "object")
+		}
+		next := v.MapIndex(reflect.ValueOf(element.String()).Convert(v.Type().Key()))
+		return resolver.resolve(ctx, validator.At(element), next, elements[1:], field)
+	}
+}
+
+func (resolver *documentResolver) resolveEach(
+	ctx context.Context,
+	validator *Validator,
+	v reflect.Value,
+	elements []PropertyPathElement,
+	field *DocumentField,
+) error {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := resolver.resolve(ctx, validator.AtIndex(i), v.Index(i), elements, field); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return resolver.addMismatch(ctx, validator, "object")
+		}
+		keys := v.MapKeys()
+		slices.SortFunc(keys, func(a, b reflect.Value) int {
+			return strings.Compare(a.String(), b.String())
+		})
+		for _, key := range keys {
+			if err := resolver.resolve(ctx, validator.AtProperty(key.String()), v.MapIndex(key), elements, field); err != nil {
+				return err
+			}
+		}
+	default:
+		return resolver.addMismatch(ctx, validator, "array")
+	}
+
+	return nil
+}
+
+func (resolver *documentResolver) addMismatch(ctx context.Context, validator *Validator, typeName string) error {
+	path := validator.propertyPath.String()
+	if resolver.mismatches[path] {
+		return nil
+	}
+	resolver.mismatches[path] = true
+
+	return resolver.violations.AppendFromError(newInvalidTypeViolation(ctx, validator, typeName))
+}
+
+func isWildcard(element PropertyPathElement) bool {
+	_, ok := element.(wildcard)
+	return ok
+}
+
+func newInvalidTypeViolation(ctx context.Context, validator *Validator, typeName string) error {
+	return validator.BuildViolation(ctx, ErrInvalidType, ErrInvalidType.Message()).
+		WithParameter("{{ type }}", typeName).
+		Create()
+}
+
+func coerceString(v reflect.Value) (*string, bool) {
+	if !v.IsValid() {
+		return nil, true
+	}
+
+	var s string
+	switch v.Kind() {
+	case reflect.String:
+		s = v.String()
+	case reflect.Bool:
+		s = strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		s = strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		s = strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		s = strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits())
+	default:
+		return nil, false
+	}
+
+	return &s, true
+}
+
+// coerceNumber converts the value into the number of type T. Numbers are converted via
+// their string representation, so the value is parsed only if it fits into the type T.
+func coerceNumber[T Numeric](v reflect.Value) (*T, bool) {
+	if !v.IsValid() {
+		return nil, true
+	}
+
+	var s string
+	switch v.Kind() {
+	case reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		p, _ := coerceString(v)
+		s = *p
+	default:
+		return nil, false
+	}
+
+	number := new(T)
+	target := reflect.ValueOf(number).Elem()
+	switch target.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, target.Type().Bits())
+		if err != nil {
+			return nil, false
+		}
+		target.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, target.Type().Bits())
+		if err != nil {
+			return nil, false
+		}
+		target.SetUint(u)
+	default:
+		f, err := strconv.ParseFloat(s, target.Type().Bits())
+		if err != nil {
+			return nil, false
+		}
+		target.SetFloat(f)
+	}
+
+	return number, true
+}
+
+func coerceBool(v reflect.Value) (*bool, bool) {
+	if !v.IsValid() {
+		return nil, true
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		b := v.Bool()
+		return &b, true
+	case reflect.String:
+		b, err := strconv.ParseBool(v.String())
+		if err != nil {
+			return nil, false
+		}
+		return &b, true
+	default:
+		return nil, false
+	}
+}
+
+// coerceTime converts the value into the time. It returns [ErrInvalidType] or [ErrInvalidDateTime]
+// if the value cannot be converted.
+func coerceTime(v reflect.Value, layout string) (*time.Time, error) {
+	if !v.IsValid() {
+		return nil, nil
+	}
+	if v.Type() == timeReflectType {
+		t, _ := v.Interface().(time.Time)
+		return &t, nil
+	}
+	if v.Kind() != reflect.String {
+		return nil, ErrInvalidType
+	}
+
+	t, err := time.Parse(layout, v.String())
+	if err != nil {
+		return nil, ErrInvalidDateTime
+	}
+
+	return &t, nil
+}
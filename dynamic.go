@@ -0,0 +1,193 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"time"
+)
+
+// ValueType is a kind of dynamic value that is validated by reflection-based arguments such as [Struct].
+// It is used to resolve constraints by name for values which type is known only at runtime.
+type ValueType string
+
+const (
+	// StringType is used for values of string kind.
+	StringType ValueType = "string"
+	// BoolType is used for values of bool kind.
+	BoolType ValueType = "bool"
+	// IntType is used for values of signed integer kinds. Values are passed to constraints as int64.
+	IntType ValueType = "int"
+	// UintType is used for values of unsigned integer kinds. Values are passed to constraints as uint64.
+	UintType ValueType = "uint"
+	// FloatType is used for values of float kinds. Values are passed to constraints as float64.
+	FloatType ValueType = "float"
+	// TimeType is used for [time.Time] values.
+	TimeType ValueType = "time"
+	// CountableType is used for arrays, slices, and maps. Constraints are applied to its length.
+	CountableType ValueType = "countable"
+	// AnyType is used for all other values. Only [NilConstraint] can be applied to them.
+	AnyType ValueType = "any"
+)
+
+var (
+	timeReflectType        = reflect.TypeFor[time.Time]()
+	validatableReflectType = reflect.TypeFor[Validatable]()
+)
+
+var errConstraintNotApplicable = errors.New("constraint is not applicable")
+
+// ValueTypeOf returns [ValueType] for the given reflection type. Pointers are dereferenced.
+func ValueTypeOf(t reflect.Type) ValueType {
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil {
+		return AnyType
+	}
+	if t == timeReflectType {
+		return TimeType
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return StringType
+	case reflect.Bool:
+		return BoolType
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return IntType
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return UintType
+	case reflect.Float32, reflect.Float64:
+		return FloatType
+	case reflect.Array, reflect.Slice, reflect.Map:
+		return CountableType
+	default:
+		return AnyType
+	}
+}
+
+// dynamicValue holds a value received by reflection with its pointers and interfaces resolved.
+type dynamicValue struct {
+	isNil     bool
+	valueType ValueType
+	value     reflect.Value
+}
+
+func newDynamicValue(value reflect.Value) dynamicValue {
+	v := dynamicValue{valueType: AnyType}
+	if !value.IsValid() {
+		v.isNil = true
+		return v
+	}
+
+	v.valueType = ValueTypeOf(value.Type())
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			v.isNil = true
+			return v
+		}
+		value = value.Elem()
+		v.valueType = ValueTypeOf(value.Type())
+	}
+	if (value.Kind() == reflect.Slice || value.Kind() == reflect.Map) && value.IsNil() {
+		v.isNil = true
+	}
+	v.value = value
+
+	return v
+}
+
+// applyConstraint applies a constraint of unknown type to the dynamic value. It selects the most specific
+// constraint interface for the value type and falls back to [NilConstraint].
+// If the constraint cannot be applied to the value, then errConstraintNotApplicable is returned.
+func applyConstraint(ctx context.Context, validator *Validator, constraint any, v dynamicValue) error {
+	switch v.valueType {
+	case StringType:
+		var value *string
+		if v.value.IsValid() {
+			s := v.value.String()
+			value = &s
+		}
+		if c, ok := constraint.(StringConstraint); ok {
+			return c.ValidateString(ctx, validator, value)
+		}
+		if c, ok := constraint.(ComparableConstraint[string]); ok {
+			return c.ValidateComparable(ctx, validator, value)
+		}
+	case BoolType:
+		var value *bool
+		if v.value.IsValid() {
+			b := v.value.Bool()
+			value = &b
+		}
+		if c, ok := constraint.(BoolConstraint); ok {
+			return c.ValidateBool(ctx, validator, value)
+		}
+		if c, ok := constraint.(ComparableConstraint[bool]); ok {
+			return c.ValidateComparable(ctx, validator, value)
+		}
+	case IntType:
+		var value *int64
+		if v.value.IsValid() {
+			i := v.value.Int()
+			value = &i
+		}
+		if ok, err := applyNumberConstraint(ctx, validator, constraint, value); ok {
+			return err
+		}
+	case UintType:
+		var value *uint64
+		if v.value.IsValid() {
+			u := v.value.Uint()
+			value = &u
+		}
+		if ok, err := applyNumberConstraint(ctx, validator, constraint, value); ok {
+			return err
+		}
+	case FloatType:
+		var value *float64
+		if v.value.IsValid() {
+			f := v.value.Float()
+			value = &f
+		}
+		if ok, err := applyNumberConstraint(ctx, validator, constraint, value); ok {
+			return err
+		}
+	case TimeType:
+		var value *time.Time
+		if v.value.IsValid() {
+			if t, ok := v.value.Interface().(time.Time); ok {
+				value = &t
+			}
+		}
+		if c, ok := constraint.(TimeConstraint); ok {
+			return c.ValidateTime(ctx, validator, value)
+		}
+	case CountableType:
+		if c, ok := constraint.(CountableConstraint); ok {
+			count := 0
+			if v.value.IsValid() {
+				count = v.value.Len()
+			}
+			return c.ValidateCountable(ctx, validator, count)
+		}
+	}
+
+	if c, ok := constraint.(NilConstraint); ok {
+		return c.ValidateNil(ctx, validator, v.isNil)
+	}
+
+	return errConstraintNotApplicable
+}
+
+func applyNumberConstraint[T Numeric](ctx context.Context, validator *Validator, constraint any, value *T) (bool, error) {
+	if c, ok := constraint.(NumberConstraint[T]); ok {
+		return true, c.ValidateNumber(ctx, validator, value)
+	}
+	if c, ok := constraint.(ComparableConstraint[T]); ok {
+		return true, c.ValidateComparable(ctx, validator, value)
+	}
+
+	return false, nil
+}
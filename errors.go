@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/muonsoft/validation/message"
 )
@@ -14,6 +15,8 @@ var (
 	ErrInvalidEAN13           = NewError("invalid EAN-13", message.InvalidEAN13)
 	ErrInvalidEAN8            = NewError("invalid EAN-8", message.InvalidEAN8)
 	ErrInvalidEmail           = NewError("invalid email", message.InvalidEmail)
+	ErrInvalidEmailLocalPart  = NewError("invalid email local part", message.InvalidEmailLocalPart)
+	ErrInvalidEmailDomain     = NewError("invalid email domain", message.InvalidEmailDomain)
 	ErrInvalidHostname        = NewError("invalid hostname", message.InvalidHostname)
 	ErrInvalidIBAN            = NewError("invalid IBAN", message.InvalidIBAN)
 	ErrInvalidBIC             = NewError("invalid BIC", message.InvalidBIC)
@@ -26,11 +29,16 @@ var (
 	ErrInvalidCurrency        = NewError("invalid currency", message.InvalidCurrency)
 	ErrInvalidCIDR            = NewError("invalid CIDR", message.InvalidCIDR)
 	ErrCIDRNetmaskOutOfRange  = NewError("CIDR netmask out of range", message.CIDRNetmaskOutOfRange)
+	ErrCIDRNotInNetworks      = NewError("CIDR not in allowed networks", message.CIDRNotInNetworks)
+	ErrCIDROverlap            = NewError("CIDR overlap", message.CIDROverlap)
+	ErrInvalidFormat          = NewError("invalid format", message.InvalidFormat)
 	ErrInvalidIP              = NewError("invalid IP address", message.InvalidIP)
+	ErrIPNotInSubnet          = NewError("IP not in subnet", message.IPNotInSubnet)
 	ErrInvalidJSON            = NewError("invalid JSON", message.InvalidJSON)
 	ErrInvalidLUHN            = NewError("invalid LUHN", message.InvalidLUHN)
 	ErrInvalidMAC             = NewError("invalid MAC address", message.InvalidMAC)
 	ErrInvalidTime            = NewError("invalid time", message.InvalidTime)
+	ErrInvalidType            = NewError("invalid type", message.InvalidType)
 	ErrInvalidULID            = NewError("invalid ULID", message.InvalidULID)
 	ErrInvalidUPCA            = NewError("invalid UPC-A", message.InvalidUPCA)
 	ErrInvalidUPCE            = NewError("invalid UPC-E", message.InvalidUPCE)
@@ -39,6 +47,7 @@ var (
 	ErrIsBlank                = NewError("is blank", message.IsBlank)
 	ErrIsEqual                = NewError("is equal", message.IsEqual)
 	ErrIsNil                  = NewError("is nil", message.IsNil)
+	ErrMissingProperty        = NewError("missing property", message.MissingProperty)
 	ErrNoSuchChoice           = NewError("no such choice", message.NoSuchChoice)
 	ErrNotBlank               = NewError("is not blank", message.NotBlank)
 	ErrNotDivisible           = NewError("is not divisible", message.NotDivisible)
@@ -72,6 +81,7 @@ var (
 	ErrTooLowOrEqual          = NewError("is too low or equal", message.TooLowOrEqual)
 	ErrTooManyElements        = NewError("too many elements", message.TooManyElements)
 	ErrTooShort               = NewError("is too short", message.TooShort)
+	ErrUnexpectedProperty     = NewError("unexpected property", message.UnexpectedProperty)
 
 	ErrSuspiciousInvisible             = NewError("suspicious invisible characters", message.SuspiciousInvisible)
 	ErrSuspiciousMixedNumbers          = NewError("suspicious mixed numbers", message.SuspiciousMixedNumbers)
@@ -88,8 +98,29 @@ type Error struct {
 }
 
 // NewError creates a static validation error. It should be used to create only package-level errors.
+//
+// The error is registered by its code, so it can be resolved by the [ErrorByCode] function.
+// It is used to restore the underlying errors of the violations decoded from the serialized form
+// (see [ViolationData]). If several errors have the same code, then the first created error is registered.
 func NewError(code string, message string) *Error {
-	return &Error{code: code, message: message}
+	err := &Error{code: code, message: message}
+	registeredErrors.LoadOrStore(code, err)
+
+	return err
+}
+
+var registeredErrors sync.Map
+
+// ErrorByCode returns the static error created by the [NewError] function with the given code.
+// It returns false if the error with the given code is not registered.
+func ErrorByCode(code string) (*Error, bool) {
+	err, ok := registeredErrors.Load(code)
+	if !ok {
+		return nil, false
+	}
+	e, ok := err.(*Error)
+
+	return e, ok
 }
 
 // Error returns error code. This code is protected by backward compatibility rules.
@@ -120,7 +151,7 @@ func (err *ConstraintError) Error() string {
 }
 
 // ConstraintNotFoundError is returned when trying to get a constraint
-// from the validator store using a non-existent key.
+// from the [ConstraintRegistry] using a non-existent key.
 type ConstraintNotFoundError struct {
 	Key  string
 	Type string
@@ -130,4 +161,36 @@ func (err *ConstraintNotFoundError) Error() string {
 	return fmt.Sprintf(`constraint by key "%s" of type "%s" is not found`, err.Key, err.Type)
 }
 
-var errTranslatorOptionsDenied = errors.New("translation options denied when using custom translator")
+// PanicError is returned when the validation running in a separate goroutine (see [Async]) panics.
+// The panic is recovered to not crash the process, and the validation process is terminated with this error.
+type PanicError struct {
+	// Path is the property path of the validator at which the panic is raised.
+	Path *PropertyPath
+	// Value is the value passed to the panic.
+	Value any
+	// Stack is the stack trace of the panicking goroutine.
+	Stack []byte
+}
+
+func (err *PanicError) Error() string {
+	var s strings.Builder
+	s.WriteString("panic during validation")
+	if err.Path != nil {
+		s.WriteString(` at path "` + err.Path.String() + `"`)
+	}
+	s.WriteString(fmt.Sprintf(": %v", err.Value))
+
+	return s.String()
+}
+
+// Unwrap returns the value passed to the panic if it is an error.
+func (err *PanicError) Unwrap() error {
+	e, _ := err.Value.(error)
+	return e
+}
+
+var (
+	errTranslatorOptionsDenied = errors.New("translation options denied when using custom translator")
+	errNegativeMaxViolations   = errors.New("maximum number of violations must not be negative")
+	errUnknownSeverity         = errors.New("unknown severity")
+)
@@ -0,0 +1,45 @@
+package validation_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/validator"
+)
+
+var ErrEmailTaken = validation.NewError("email is taken", "Email {{ value }} is already registered.")
+
+// registeredEmails imitates the storage of users.
+var registeredEmails = map[string]bool{"john@example.com": true}
+
+func ExampleNewAsyncConstraint() {
+	isEmailNotRegistered := validation.NewAsyncConstraint[string](
+		validation.AsyncLoaderFunc[string](func(ctx context.Context, emails []string) (map[string]bool, error) {
+			// all the emails are loaded by one query
+			fmt.Println("loading:", strings.Join(emails, ", "))
+			results := make(map[string]bool, len(emails))
+			for _, email := range emails {
+				results[email] = !registeredEmails[email]
+			}
+			return results, nil
+		}),
+	).WithError(ErrEmailTaken).WithMessage(ErrEmailTaken.Message())
+
+	emails := []string{"john@example.com", "jane@example.com", "john@example.com"}
+	err := validator.Validate(
+		context.Background(),
+		validation.EachProperty("emails", emails, isEmailNotRegistered),
+	)
+
+	if violations, ok := validation.UnwrapViolations(err); ok {
+		for _, violation := range violations.All() {
+			fmt.Println(violation)
+		}
+	}
+	// Output:
+	// loading: john@example.com, jane@example.com
+	// violation at "emails[0]": "Email "john@example.com" is already registered."
+	// violation at "emails[2]": "Email "john@example.com" is already registered."
+}
@@ -0,0 +1,43 @@
+package validation_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/validator"
+)
+
+func ExampleDocument() {
+	var order map[string]any
+	err := json.Unmarshal([]byte(`{
+		"customer": {"email": "invalid"},
+		"items": [
+			{"price": 10.5, "quantity": "2"},
+			{"price": -1, "quantity": 1.5}
+		]
+	}`), &order)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = validator.Validate(context.Background(), validation.Document(
+		order,
+		validation.SelectString("customer.email", it.IsNotBlank(), it.IsEmail()),
+		validation.SelectNumber[float64]("items[*].price", it.IsPositive[float64]()),
+		validation.SelectNumber[int]("items[*].quantity", it.IsBetween(1, 100)),
+	))
+
+	if violations, ok := validation.UnwrapViolations(err); ok {
+		for _, violation := range violations.All() {
+			fmt.Println(violation)
+		}
+	}
+	// Output:
+	// violation at "customer.email": "This value is not a valid email address."
+	// violation at "items[1].price": "This value should be positive."
+	// violation at "items[1].quantity": "This value should be of type integer."
+}
@@ -0,0 +1,57 @@
+package validation_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/validator"
+)
+
+func ExampleValidator_DescribeJSONSchema() {
+	schema, err := validator.DescribeJSONSchema(
+		context.Background(),
+		validation.StringProperty("email", "", it.IsNotBlank(), it.IsEmail(), it.HasMaxLength(255)),
+		validation.NumberProperty[int]("age", 0, it.IsBetween(18, 100)),
+		validation.StringProperty("role", "", it.IsOneOf("admin", "user")),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(string(data))
+	// Output:
+	// {
+	//   "$schema": "https://json-schema.org/draft/2020-12/schema",
+	//   "type": "object",
+	//   "properties": {
+	//     "age": {
+	//       "type": "integer",
+	//       "minimum": 18,
+	//       "maximum": 100
+	//     },
+	//     "email": {
+	//       "type": "string",
+	//       "format": "email",
+	//       "maxLength": 255
+	//     },
+	//     "role": {
+	//       "type": "string",
+	//       "enum": [
+	//         "admin",
+	//         "user"
+	//       ]
+	//     }
+	//   },
+	//   "required": [
+	//     "email"
+	//   ]
+	// }
+}
@@ -0,0 +1,51 @@
+package validation_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/validator"
+)
+
+type Author struct {
+	Name string
+}
+
+func (a *Author) Validate(ctx context.Context, validator *validation.Validator) error {
+	fmt.Println("validating author")
+	return validator.Validate(ctx, validation.StringProperty("name", a.Name, it.IsNotBlank()))
+}
+
+type Novel struct {
+	Title  string
+	Author *Author
+}
+
+func (n Novel) Validate(ctx context.Context, validator *validation.Validator) error {
+	return validator.Validate(ctx,
+		validation.StringProperty("title", n.Title, it.IsNotBlank()),
+		validation.ValidProperty("author", n.Author),
+	)
+}
+
+func ExampleValidator_WithMemoization() {
+	author := &Author{}
+	novels := []Novel{
+		{Title: "The First Novel", Author: author},
+		{Title: "The Second Novel", Author: author},
+	}
+
+	err := validator.WithMemoization().Validate(context.Background(), validation.ValidSliceProperty("novels", novels))
+
+	if violations, ok := validation.UnwrapViolations(err); ok {
+		for _, violation := range violations.All() {
+			fmt.Println(violation)
+		}
+	}
+	// Output:
+	// validating author
+	// violation at "novels[0].author.name": "This value should not be blank."
+	// violation at "novels[1].author.name": "This value should not be blank."
+}
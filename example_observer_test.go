@@ -0,0 +1,33 @@
+package validation_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+)
+
+func ExampleNewCountingObserver() {
+	observer := validation.NewCountingObserver()
+	v, err := validation.NewValidator(validation.SetObserver(observer))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = v.Validate(
+		context.Background(),
+		validation.EachStringProperty("tags", []string{"", "go", "validation"}, it.IsNotBlank(), it.HasMinLength(3)),
+	)
+
+	fmt.Println(err)
+	fmt.Println("arguments:", observer.Arguments())
+	fmt.Println("violations:", observer.Violations())
+	fmt.Println("evaluations:", observer.Constraints()["it.LengthConstraint"].Evaluations)
+	// Output:
+	// violations: #0 at "tags[0]": "This value should not be blank."; #1 at "tags[1]": "This value is too short. It should have 3 characters or more."
+	// arguments: 1
+	// violations: map[is blank:1 is too short:1]
+	// evaluations: 3
+}
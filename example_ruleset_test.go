@@ -0,0 +1,41 @@
+package validation_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/muonsoft/validation"
+	_ "github.com/muonsoft/validation/it" // registers built-in constraints for rule sets
+	"github.com/muonsoft/validation/validator"
+)
+
+func ExampleRuleSet() {
+	// rules can be stored in configuration files (JSON or YAML, see the yamlvalidation module)
+	ruleSet, err := validation.ParseRuleSetJSON([]byte(`{
+		"name": ["notblank", "length=1..50"],
+		"email": ["notblank", "email"],
+		"addresses[0].city": ["notblank"]
+	}`))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var form map[string]any
+	err = json.Unmarshal([]byte(`{"name": "John", "email": "invalid", "addresses": [{}]}`), &form)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = validator.Validate(context.Background(), ruleSet.For(form))
+
+	if violations, ok := validation.UnwrapViolations(err); ok {
+		for _, violation := range violations.All() {
+			fmt.Println(violation)
+		}
+	}
+	// Output:
+	// violation at "addresses[0].city": "This value should not be blank."
+	// violation at "email": "This value is not a valid email address."
+}
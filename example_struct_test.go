@@ -0,0 +1,44 @@
+package validation_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/muonsoft/validation"
+	_ "github.com/muonsoft/validation/it" // registers built-in constraints for struct tags
+	"github.com/muonsoft/validation/validator"
+)
+
+type Address struct {
+	City   string `json:"city" validate:"notblank"`
+	Street string `json:"street" validate:"notblank,max=100"`
+}
+
+type Customer struct {
+	Name      string    `json:"name" validate:"notblank,length=1..50"`
+	Email     string    `json:"email" validate:"notblank,email"`
+	Age       int       `json:"age" validate:"between=18..100"`
+	Tags      []string  `json:"tags" validate:"max=3"`
+	Addresses []Address `json:"addresses" validate:"min=1"`
+}
+
+func ExampleStruct() {
+	customer := Customer{
+		Name:      "John",
+		Email:     "invalid",
+		Age:       16,
+		Addresses: []Address{{City: "Moscow"}},
+	}
+
+	err := validator.Validate(context.Background(), validation.Struct(customer))
+
+	if violations, ok := validation.UnwrapViolations(err); ok {
+		for _, violation := range violations.All() {
+			fmt.Println(violation)
+		}
+	}
+	// Output:
+	// violation at "email": "This value is not a valid email address."
+	// violation at "age": "This value should be between 18 and 100."
+	// violation at "addresses[0].street": "This value should not be blank."
+}
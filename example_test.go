@@ -1014,6 +1014,25 @@ func ExampleValidator_WithLanguage() {
 	// violation: "Значение не должно быть пустым."
 }
 
+func ExampleValidator_WithMaxViolations() {
+	tags := []string{"", "", "", "", ""}
+	err := validator.WithMaxViolations(2).Validate(
+		context.Background(),
+		validation.EachStringProperty("tags", tags, it.IsNotBlank()),
+	)
+
+	if violations, ok := validation.UnwrapViolations(err); ok {
+		for _, violation := range violations.All() {
+			fmt.Println(violation)
+		}
+		fmt.Println("truncated:", violations.IsTruncated())
+	}
+	// Output:
+	// violation at "tags[0]": "This value should not be blank."
+	// violation at "tags[1]": "This value should not be blank."
+	// truncated: true
+}
+
 func ExampleValidator_Validate_translationsByDefaultLanguage() {
 	validator, err := validation.NewValidator(
 		validation.Translations(russian.Messages),
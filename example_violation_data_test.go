@@ -0,0 +1,38 @@
+package validation_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/validator"
+)
+
+func ExampleNewViolationListData() {
+	err := validator.Validate(
+		context.Background(),
+		validation.StringProperty("title", "", it.IsNotBlank()),
+		validation.StringProperty("author", "Alice Smith", it.HasMaxLength(5)),
+	)
+	violations, _ := validation.UnwrapViolations(err)
+
+	// encoding violations on the server side
+	data, _ := json.Marshal(validation.NewViolationListData(violations))
+
+	// decoding violations on the client side
+	var decoded validation.ViolationListData
+	_ = json.Unmarshal(data, &decoded)
+	restored, _ := decoded.ViolationList()
+
+	for _, violation := range restored.All() {
+		fmt.Printf("%s: %s (%s)\n", violation.PropertyPath(), violation.Message(), violation.Unwrap())
+	}
+	fmt.Println("is validation.ErrIsBlank =", errors.Is(restored, validation.ErrIsBlank))
+	// Output:
+	// title: This value should not be blank. (is blank)
+	// author: This value is too long. It should have 5 characters or less. (is too long)
+	// is validation.ErrIsBlank = true
+}
@@ -2,6 +2,7 @@ package validation
 
 import (
 	"context"
+	"runtime"
 	"time"
 )
 
@@ -11,7 +12,7 @@ type executionContext struct {
 
 func (ctx *executionContext) addValidation(validate ValidateFunc, path ...PropertyPathElement) {
 	ctx.validations = append(ctx.validations, func(ctx context.Context, validator *Validator) (*ViolationList, error) {
-		return validate(ctx, validator.At(path...))
+		return validator.At(path...).validateArgument(ctx, validate)
 	})
 }
 
@@ -20,7 +21,9 @@ func validateNil(isNil bool, constraints []NilConstraint) ValidateFunc {
 		violations := NewViolationList()
 
 		for i := range constraints {
-			err := violations.AppendFromError(constraints[i].ValidateNil(ctx, validator, isNil))
+			err := violations.AppendFromError(validator.evaluate(ctx, constraints[i], func() error {
+				return constraints[i].ValidateNil(ctx, validator, isNil)
+			}))
 			if err != nil {
 				return nil, err
 			}
@@ -35,7 +38,9 @@ func validateBool(value *bool, constraints []BoolConstraint) ValidateFunc {
 		violations := NewViolationList()
 
 		for i := range constraints {
-			err := violations.AppendFromError(constraints[i].ValidateBool(ctx, validator, value))
+			err := violations.AppendFromError(validator.evaluate(ctx, constraints[i], func() error {
+				return constraints[i].ValidateBool(ctx, validator, value)
+			}))
 			if err != nil {
 				return nil, err
 			}
@@ -50,7 +55,9 @@ func validateNumber[T Numeric](value *T, constraints []NumberConstraint[T]) Vali
 		violations := NewViolationList()
 
 		for i := range constraints {
-			err := violations.AppendFromError(constraints[i].ValidateNumber(ctx, validator, value))
+			err := violations.AppendFromError(validator.evaluate(ctx, constraints[i], func() error {
+				return constraints[i].ValidateNumber(ctx, validator, value)
+			}))
 			if err != nil {
 				return nil, err
 			}
@@ -65,7 +72,9 @@ func validateString(value *string, constraints []StringConstraint) ValidateFunc
 		violations := NewViolationList()
 
 		for i := range constraints {
-			err := violations.AppendFromError(constraints[i].ValidateString(ctx, validator, value))
+			err := violations.AppendFromError(validator.evaluate(ctx, constraints[i], func() error {
+				return constraints[i].ValidateString(ctx, validator, value)
+			}))
 			if err != nil {
 				return nil, err
 			}
@@ -80,7 +89,9 @@ func validateCountable(count int, constraints []CountableConstraint) ValidateFun
 		violations := NewViolationList()
 
 		for i := range constraints {
-			err := violations.AppendFromError(constraints[i].ValidateCountable(ctx, validator, count))
+			err := violations.AppendFromError(validator.evaluate(ctx, constraints[i], func() error {
+				return constraints[i].ValidateCountable(ctx, validator, count)
+			}))
 			if err != nil {
 				return nil, err
 			}
@@ -95,7 +106,9 @@ func validateTime(value *time.Time, constraints []TimeConstraint) ValidateFunc {
 		violations := NewViolationList()
 
 		for i := range constraints {
-			err := violations.AppendFromError(constraints[i].ValidateTime(ctx, validator, value))
+			err := violations.AppendFromError(validator.evaluate(ctx, constraints[i], func() error {
+				return constraints[i].ValidateTime(ctx, validator, value)
+			}))
 			if err != nil {
 				return nil, err
 			}
@@ -110,8 +123,14 @@ func validateEachString(values []string, constraints []StringConstraint) Validat
 		violations := NewViolationList()
 
 		for i := range values {
+			if validator.isLimitReached() {
+				break
+			}
+			v := validator.AtIndex(i)
 			for _, constraint := range constraints {
-				err := violations.AppendFromError(constraint.ValidateString(ctx, validator.AtIndex(i), &values[i]))
+				err := violations.AppendFromError(v.evaluate(ctx, constraint, func() error {
+					return constraint.ValidateString(ctx, v, &values[i])
+				}))
 				if err != nil {
 					return nil, err
 				}
@@ -127,8 +146,14 @@ func validateEachNumber[T Numeric](values []T, constraints []NumberConstraint[T]
 		violations := NewViolationList()
 
 		for i := range values {
+			if validator.isLimitReached() {
+				break
+			}
+			v := validator.AtIndex(i)
 			for _, constraint := range constraints {
-				err := violations.AppendFromError(constraint.ValidateNumber(ctx, validator.AtIndex(i), &values[i]))
+				err := violations.AppendFromError(v.evaluate(ctx, constraint, func() error {
+					return constraint.ValidateNumber(ctx, v, &values[i])
+				}))
 				if err != nil {
 					return nil, err
 				}
@@ -141,11 +166,20 @@ func validateEachNumber[T Numeric](values []T, constraints []NumberConstraint[T]
 
 func validateEachComparable[T comparable](values []T, constraints []ComparableConstraint[T]) ValidateFunc {
 	return func(ctx context.Context, validator *Validator) (*ViolationList, error) {
+		if err := loadBatches(ctx, validator, values, constraints); err != nil {
+			return nil, err
+		}
 		violations := NewViolationList()
 
 		for i := range values {
+			if validator.isLimitReached() {
+				break
+			}
+			v := validator.AtIndex(i)
 			for _, constraint := range constraints {
-				err := violations.AppendFromError(constraint.ValidateComparable(ctx, validator.AtIndex(i), &values[i]))
+				err := violations.AppendFromError(v.evaluate(ctx, constraint, func() error {
+					return constraint.ValidateComparable(ctx, v, &values[i])
+				}))
 				if err != nil {
 					return nil, err
 				}
@@ -158,12 +192,20 @@ func validateEachComparable[T comparable](values []T, constraints []ComparableCo
 
 func validateEach[E any](items []E, constraints []Constraint[E]) ValidateFunc {
 	return func(ctx context.Context, validator *Validator) (*ViolationList, error) {
+		if err := loadBatches(ctx, validator, items, constraints); err != nil {
+			return nil, err
+		}
 		violations := NewViolationList()
 
 		for i := range items {
+			if validator.isLimitReached() {
+				break
+			}
 			v := validator.AtIndex(i)
 			for _, c := range constraints {
-				err := violations.AppendFromError(c.Validate(ctx, v, items[i]))
+				err := violations.AppendFromError(v.evaluate(ctx, c, func() error {
+					return c.Validate(ctx, v, items[i])
+				}))
 				if err != nil {
 					return nil, err
 				}
@@ -174,9 +216,32 @@ func validateEach[E any](items []E, constraints []Constraint[E]) ValidateFunc {
 	}
 }
 
+func validateEachParallel[E any](items []E, workers int, constraints []Constraint[E]) ValidateFunc {
+	validate := validateParallel(len(items), workers, func(ctx context.Context, validator *Validator, i int, violations *ViolationList) error {
+		for _, c := range constraints {
+			err := violations.AppendFromError(validator.evaluate(ctx, c, func() error {
+				return c.Validate(ctx, validator, items[i])
+			}))
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return func(ctx context.Context, validator *Validator) (*ViolationList, error) {
+		if err := loadBatches(ctx, validator, items, constraints); err != nil {
+			return nil, err
+		}
+
+		return validate(ctx, validator)
+	}
+}
+
 func validateIt(value Validatable) ValidateFunc {
 	return func(ctx context.Context, validator *Validator) (*ViolationList, error) {
-		err := value.Validate(ctx, validator)
+		err := validator.validateIt(ctx, value)
 		violations, ok := UnwrapViolations(err)
 		if ok {
 			return violations, nil
@@ -191,7 +256,10 @@ func validateSlice[T Validatable](values []T) ValidateFunc {
 		violations := NewViolationList()
 
 		for i, value := range values {
-			err := violations.AppendFromError(value.Validate(ctx, validator.AtIndex(i)))
+			if validator.isLimitReached() {
+				break
+			}
+			err := violations.AppendFromError(validator.AtIndex(i).validateIt(ctx, value))
 			if err != nil {
 				return nil, err
 			}
@@ -201,12 +269,66 @@ func validateSlice[T Validatable](values []T) ValidateFunc {
 	}
 }
 
+func validateSliceParallel[T Validatable](values []T, workers int) ValidateFunc {
+	return validateParallel(len(values), workers, func(ctx context.Context, validator *Validator, i int, violations *ViolationList) error {
+		return violations.AppendFromError(validator.validateIt(ctx, values[i]))
+	})
+}
+
+// parallelChunksPerWorker is the number of chunks per worker used to balance the load
+// when the validation of the elements takes different time.
+const parallelChunksPerWorker = 4
+
+// validateParallel splits the elements into contiguous chunks validated by [AsyncArgument]
+// with the concurrency limited by the number of workers. The validator passed to the function
+// is already set at the index of the element, violations of the element are appended to the list of the chunk.
+func validateParallel(
+	count, workers int,
+	validate func(ctx context.Context, validator *Validator, i int, violations *ViolationList) error,
+) ValidateFunc {
+	return func(ctx context.Context, validator *Validator) (*ViolationList, error) {
+		if count == 0 {
+			return nil, nil
+		}
+		if workers <= 0 {
+			workers = runtime.GOMAXPROCS(0)
+		}
+
+		size := max(1, (count+workers*parallelChunksPerWorker-1)/(workers*parallelChunksPerWorker))
+		chunks := make([]Argument, 0, (count+size-1)/size)
+		for start := 0; start < count; start += size {
+			end := min(start+size, count)
+			chunks = append(chunks, NewArgument(func(ctx context.Context, validator *Validator) (*ViolationList, error) {
+				violations := NewViolationList()
+				for i := start; i < end; i++ {
+					if err := ctx.Err(); err != nil {
+						return nil, err
+					}
+					if validator.isLimitReached() {
+						break
+					}
+					if err := validate(ctx, validator.AtIndex(i), i, violations); err != nil {
+						return nil, err
+					}
+				}
+
+				return violations, nil
+			}))
+		}
+
+		return Async(chunks...).WithConcurrency(workers).validate(ctx, validator)
+	}
+}
+
 func validateMap[T Validatable](values map[string]T) ValidateFunc {
 	return func(ctx context.Context, validator *Validator) (*ViolationList, error) {
 		violations := NewViolationList()
 
 		for key, value := range values {
-			err := violations.AppendFromError(value.Validate(ctx, validator.AtProperty(key)))
+			if validator.isLimitReached() {
+				break
+			}
+			err := violations.AppendFromError(validator.AtProperty(key).validateIt(ctx, value))
 			if err != nil {
 				return nil, err
 			}
@@ -221,7 +343,9 @@ func validateComparable[T comparable](value *T, constraints []ComparableConstrai
 		violations := NewViolationList()
 
 		for i := range constraints {
-			err := violations.AppendFromError(constraints[i].ValidateComparable(ctx, validator, value))
+			err := violations.AppendFromError(validator.evaluate(ctx, constraints[i], func() error {
+				return constraints[i].ValidateComparable(ctx, validator, value)
+			}))
 			if err != nil {
 				return nil, err
 			}
@@ -236,7 +360,9 @@ func validateComparables[T comparable](values []T, constraints []ComparablesCons
 		violations := NewViolationList()
 
 		for i := range constraints {
-			err := violations.AppendFromError(constraints[i].ValidateComparables(ctx, validator, values))
+			err := violations.AppendFromError(validator.evaluate(ctx, constraints[i], func() error {
+				return constraints[i].ValidateComparables(ctx, validator, values)
+			}))
 			if err != nil {
 				return nil, err
 			}
@@ -251,7 +377,9 @@ func validateSliceWithConstraints[T any](values []T, constraints []SliceConstrai
 		violations := NewViolationList()
 
 		for i := range constraints {
-			err := violations.AppendFromError(constraints[i].ValidateSlice(ctx, validator, values))
+			err := violations.AppendFromError(validator.evaluate(ctx, constraints[i], func() error {
+				return constraints[i].ValidateSlice(ctx, validator, values)
+			}))
 			if err != nil {
 				return nil, err
 			}
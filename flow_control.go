@@ -2,6 +2,8 @@ package validation
 
 import (
 	"context"
+	"errors"
+	"runtime/debug"
 	"sync"
 )
 
@@ -147,7 +149,7 @@ func (arg SequentialArgument) validate(ctx context.Context, validator *Validator
 		if err != nil {
 			return nil, err
 		}
-		if violations.len > 0 {
+		if violations.HasErrors() {
 			return violations, nil
 		}
 	}
@@ -194,16 +196,25 @@ func (arg AtLeastOneOfArgument) validate(ctx context.Context, validator *Validat
 	violations := &ViolationList{}
 
 	for _, argument := range arg.arguments {
-		violation := validator.Validate(ctx, argument)
-		if violation == nil {
-			return nil, nil
-		}
-
-		err := violations.AppendFromError(violation)
+		argumentViolations := &ViolationList{}
+		err := argumentViolations.AppendFromError(validator.Validate(ctx, argument))
 		if err != nil {
 			return nil, err
 		}
+		// the argument skipped because of the limit of violations is not satisfied
+		if argumentViolations.len == 0 && validator.isLimitReached() {
+			break
+		}
+		// the argument with only non-blocking violations is satisfied
+		if !argumentViolations.HasErrors() {
+			return argumentViolations, nil
+		}
+
+		// violations are not counted until all the arguments are checked
+		validator.discardViolations(argumentViolations.len)
+		violations.Join(argumentViolations)
 	}
+	validator.restoreViolations(violations.len)
 
 	return violations, nil
 }
@@ -261,14 +272,24 @@ func (arg AllArgument) validate(ctx context.Context, validator *Validator) (*Vio
 	return violations, nil
 }
 
-// AsyncArgument can be used to interrupt validation process when the first violation is raised.
+// AsyncArgument runs validation for each argument in a separate goroutine and merges the results.
+// Use the [Async] function to create it.
 type AsyncArgument struct {
-	isIgnored bool
-	path      []PropertyPathElement
-	arguments []Argument
+	isIgnored   bool
+	failFast    bool
+	concurrency int
+	path        []PropertyPathElement
+	arguments   []Argument
 }
 
 // Async implements async/await pattern and runs validation for each argument in a separate goroutine.
+// It waits for all the arguments and returns the violations in the order of the arguments
+// regardless of the order of completion.
+//
+// If one of the arguments returns an error that is not a violation, then the context passed
+// to the other arguments is canceled and the validation process is terminated with this error.
+// If the validation of an argument panics, then the panic is recovered and the validation process
+// is terminated with [PanicError].
 func Async(arguments ...Argument) AsyncArgument {
 	return AsyncArgument{arguments: arguments}
 }
@@ -286,6 +307,25 @@ func (arg AsyncArgument) When(condition bool) AsyncArgument {
 	return arg
 }
 
+// WithConcurrency limits the number of arguments validated at the same time.
+// If the limit is zero or negative, then all arguments are validated at the same time.
+// If the validation is interrupted while waiting for the free slot, then the remaining arguments
+// are skipped. If it is interrupted by the cancellation of the parent context, then the validation
+// process is terminated with the context error.
+func (arg AsyncArgument) WithConcurrency(limit int) AsyncArgument {
+	arg.concurrency = limit
+	return arg
+}
+
+// FailFast enables interruption of the validation process on the first violation.
+// The context passed to the other arguments is canceled, arguments waiting for the free slot
+// (see [AsyncArgument.WithConcurrency]) are skipped, and the violations of the completed arguments
+// are returned. Errors of the canceled arguments caused by the cancellation ([context.Canceled]) are ignored.
+func (arg AsyncArgument) FailFast() AsyncArgument {
+	arg.failFast = true
+	return arg
+}
+
 func (arg AsyncArgument) setUp(ctx *executionContext) {
 	ctx.addValidation(arg.validate, arg.path...)
 }
@@ -295,28 +335,125 @@ func (arg AsyncArgument) validate(ctx context.Context, validator *Validator) (*V
 		return nil, nil
 	}
 
-	ctx, cancel := context.WithCancel(ctx)
+	run := &asyncRun{
+		failFast: arg.failFast,
+		results:  make([]error, len(arg.arguments)),
+	}
+	runCtx, cancel := context.WithCancel(ctx)
+	run.cancel = cancel
 	defer cancel()
 
+	var semaphore chan struct{}
+	if arg.concurrency > 0 {
+		semaphore = make(chan struct{}, arg.concurrency)
+	}
+
 	waiter := &sync.WaitGroup{}
-	waiter.Add(len(arg.arguments))
-	errs := make(chan error)
-	for _, argument := range arg.arguments {
-		go func(argument Argument) {
+	launched := 0
+	for i, argument := range arg.arguments {
+		if !acquireSlot(runCtx, semaphore) {
+			break
+		}
+
+		launched++
+		waiter.Add(1)
+		go func() {
 			defer waiter.Done()
-			errs <- validator.Validate(ctx, argument)
-		}(argument)
+			if semaphore != nil {
+				defer func() { <-semaphore }()
+			}
+			run.validate(runCtx, validator, i, argument)
+		}()
+	}
+	waiter.Wait()
+
+	if launched < len(arg.arguments) && !run.isInterrupted() {
+		// the parent context is canceled while waiting for the free slot
+		return nil, ctx.Err()
 	}
 
-	go func() {
-		waiter.Wait()
-		close(errs)
+	return run.merge()
+}
+
+// acquireSlot waits for the free slot in the semaphore. It returns false if the context is canceled.
+// Nil semaphore means unlimited concurrency.
+func acquireSlot(ctx context.Context, semaphore chan struct{}) bool {
+	if semaphore == nil {
+		return true
+	}
+
+	select {
+	case semaphore <- struct{}{}:
+	case <-ctx.Done():
+		return false
+	}
+	if ctx.Err() != nil {
+		<-semaphore
+		return false
+	}
+
+	return true
+}
+
+// asyncRun holds the state of the single run of the [AsyncArgument].
+type asyncRun struct {
+	failFast bool
+	cancel   context.CancelFunc
+	// results holds the error returned by each argument at its index
+	results []error
+
+	mu sync.Mutex
+	// interruption is the first error that canceled the context of the run
+	interruption error
+}
+
+func (run *asyncRun) validate(ctx context.Context, validator *Validator, index int, argument Argument) {
+	defer func() {
+		if r := recover(); r != nil {
+			run.interrupt(&PanicError{Path: validator.propertyPath, Value: r, Stack: debug.Stack()})
+		}
 	}()
 
+	err := validator.Validate(ctx, argument)
+	run.results[index] = err
+	if err == nil {
+		return
+	}
+	violations, ok := UnwrapViolations(err)
+	if !ok || run.failFast && violations.HasErrors() {
+		run.interrupt(err)
+	}
+}
+
+func (run *asyncRun) interrupt(err error) {
+	run.mu.Lock()
+	defer run.mu.Unlock()
+
+	if run.interruption == nil {
+		run.interruption = err
+		run.cancel()
+	}
+}
+
+func (run *asyncRun) isInterrupted() bool {
+	run.mu.Lock()
+	defer run.mu.Unlock()
+
+	return run.interruption != nil
+}
+
+func (run *asyncRun) merge() (*ViolationList, error) {
+	if _, ok := UnwrapViolations(run.interruption); run.interruption != nil && !ok {
+		return nil, run.interruption
+	}
+
 	violations := &ViolationList{}
 
-	for violation := range errs {
-		err := violations.AppendFromError(violation)
+	for _, result := range run.results {
+		if run.interruption != nil && errors.Is(result, context.Canceled) {
+			continue
+		}
+		err := violations.AppendFromError(result)
 		if err != nil {
 			return nil, err
 		}
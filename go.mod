@@ -4,7 +4,9 @@ go 1.24.0
 
 require (
 	github.com/muonsoft/language v0.3.1
+	github.com/santhosh-tekuri/jsonschema/v6 v6.0.2
 	github.com/stretchr/testify v1.11.1
+	golang.org/x/net v0.41.0
 	golang.org/x/text v0.33.0
 )
 
@@ -0,0 +1,32 @@
+package grpcvalidation_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/grpcvalidation"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/validator"
+	"google.golang.org/grpc/status"
+)
+
+func ExampleError() {
+	err := validator.Validate(
+		context.Background(),
+		validation.StringProperty("title", "", it.IsNotBlank()),
+	)
+
+	err = grpcvalidation.Error(err)
+
+	st, _ := status.FromError(err)
+	fmt.Println(st.Code(), st.Message())
+	violations, ok := grpcvalidation.UnwrapViolations(err)
+	fmt.Println(ok, errors.Is(violations, validation.ErrIsBlank))
+	fmt.Println(violations)
+	// Output:
+	// InvalidArgument validation failed
+	// true true
+	// violation at "title": "This value should not be blank."
+}
@@ -0,0 +1,125 @@
+// Package grpcvalidation contains an adapter to pass violations via gRPC status details.
+//
+// Violations are converted into the google.rpc.BadRequest field violations inside the status
+// with the InvalidArgument code: the field is the string representation of the property path,
+// the description is the translated message, and the reason is the code of the underlying error.
+// Clients can convert the status back into the [validation.ViolationList].
+package grpcvalidation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/muonsoft/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StatusMessage is the message of the status created from the violations.
+const StatusMessage = "validation failed"
+
+// NewStatus creates the status with the InvalidArgument code and the google.rpc.BadRequest details
+// containing the violations.
+func NewStatus(violations *validation.ViolationList) *status.Status {
+	st := status.New(codes.InvalidArgument, StatusMessage)
+	withDetails, err := st.WithDetails(NewBadRequest(violations))
+	if err != nil {
+		// the details are always serializable, so it should never happen
+		return st
+	}
+
+	return withDetails
+}
+
+// NewBadRequest converts the violations into the google.rpc.BadRequest field violations.
+func NewBadRequest(violations *validation.ViolationList) *errdetails.BadRequest {
+	badRequest := &errdetails.BadRequest{
+		FieldViolations: make([]*errdetails.BadRequest_FieldViolation, 0, violations.Len()),
+	}
+	for _, violation := range violations.All() {
+		fieldViolation := &errdetails.BadRequest_FieldViolation{
+			Description: violation.Message(),
+		}
+		if path := violation.PropertyPath(); path != nil {
+			fieldViolation.Field = path.String()
+		}
+		if err := violation.Unwrap(); err != nil {
+			fieldViolation.Reason = err.Error()
+		}
+		badRequest.FieldViolations = append(badRequest.FieldViolations, fieldViolation)
+	}
+
+	return badRequest
+}
+
+// Error converts the error containing violations (see [validation.UnwrapViolations])
+// into the status error created by [NewStatus]. Other errors are returned as is.
+func Error(err error) error {
+	violations, ok := validation.UnwrapViolations(err)
+	if !ok {
+		return err
+	}
+
+	return NewStatus(violations).Err()
+}
+
+// UnaryServerInterceptor returns the server interceptor that converts the violations
+// returned by the handlers into the status errors (see [Error]).
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, request any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		response, err := handler(ctx, request)
+		if err != nil {
+			return nil, Error(err)
+		}
+
+		return response, nil
+	}
+}
+
+// FromStatus converts the google.rpc.BadRequest details of the status back into the violations.
+// Underlying errors of the violations are resolved by their codes (see [validation.ErrorByCode]),
+// so they can be tested by [errors.Is] with the static errors. If the status has no
+// google.rpc.BadRequest details, then an empty list is returned.
+func FromStatus(st *status.Status) (*validation.ViolationList, error) {
+	violations := validation.NewViolationList()
+
+	for _, detail := range st.Details() {
+		badRequest, ok := detail.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+		for i, fieldViolation := range badRequest.GetFieldViolations() {
+			data := &validation.ViolationData{
+				Error:        fieldViolation.GetReason(),
+				Message:      fieldViolation.GetDescription(),
+				PropertyPath: fieldViolation.GetField(),
+			}
+			violation, err := data.Violation()
+			if err != nil {
+				return nil, fmt.Errorf("decode field violation #%d: %w", i, err)
+			}
+			violations.Append(violation)
+		}
+	}
+
+	return violations, nil
+}
+
+// UnwrapViolations converts the status error into the violations (see [FromStatus]).
+// It returns false if the error is not a status error with the InvalidArgument code
+// or the status does not contain violations.
+func UnwrapViolations(err error) (*validation.ViolationList, bool) {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		return nil, false
+	}
+
+	violations, err := FromStatus(st)
+	if err != nil || violations.Len() == 0 {
+		return nil, false
+	}
+
+	return violations, true
+}
@@ -0,0 +1,213 @@
+package grpcvalidation_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/grpcvalidation"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/validationtest"
+	"github.com/muonsoft/validation/validator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const createBookMethod = "/test.BookService/CreateBook"
+
+var errBookStorage = errors.New("storage is unavailable")
+
+// bookServiceDesc is a manually defined service accepting a book as a struct.
+var bookServiceDesc = grpc.ServiceDesc{
+	ServiceName: "test.BookService",
+	HandlerType: (*any)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "CreateBook", Handler: handleCreateBook},
+	},
+}
+
+func handleCreateBook(
+	server any,
+	ctx context.Context,
+	decode func(any) error,
+	interceptor grpc.UnaryServerInterceptor,
+) (any, error) {
+	book := &structpb.Struct{}
+	if err := decode(book); err != nil {
+		return nil, err
+	}
+	handler := func(ctx context.Context, request any) (any, error) {
+		book, _ := request.(*structpb.Struct)
+		if book.GetFields()["storageDown"].GetBoolValue() {
+			return nil, errBookStorage
+		}
+		tags := make([]string, 0)
+		for _, tag := range book.GetFields()["tags"].GetListValue().GetValues() {
+			tags = append(tags, tag.GetStringValue())
+		}
+		err := validator.Validate(ctx,
+			validation.StringProperty("title", book.GetFields()["title"].GetStringValue(), it.IsNotBlank()),
+			validation.EachStringProperty("tags", tags, it.HasMaxLength(5)),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}
+	if interceptor == nil {
+		return handler(ctx, book)
+	}
+
+	return interceptor(ctx, book, &grpc.UnaryServerInfo{Server: server, FullMethod: createBookMethod}, handler)
+}
+
+func newBookClient(t *testing.T) *grpc.ClientConn {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.UnaryInterceptor(grpcvalidation.UnaryServerInterceptor()))
+	server.RegisterService(&bookServiceDesc, struct{}{})
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return conn
+}
+
+func createBook(t *testing.T, conn *grpc.ClientConn, fields map[string]any) error {
+	t.Helper()
+	book, err := structpb.NewStruct(fields)
+	require.NoError(t, err)
+
+	return conn.Invoke(context.Background(), createBookMethod, book, &emptypb.Empty{})
+}
+
+func TestUnaryServerInterceptor_WhenViolations_ExpectInvalidArgumentWithBadRequest(t *testing.T) {
+	conn := newBookClient(t)
+
+	err := createBook(t, conn, map[string]any{"title": "", "tags": []any{"go", "validation"}})
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, grpcvalidation.StatusMessage, st.Message())
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	assert.True(t, proto.Equal(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "title", Description: "This value should not be blank.", Reason: "is blank"},
+			{
+				Field:       "tags[1]",
+				Description: "This value is too long. It should have 5 characters or less.",
+				Reason:      "is too long",
+			},
+		},
+	}, badRequest))
+}
+
+func TestUnwrapViolations_WhenStatusFromServer_ExpectViolations(t *testing.T) {
+	conn := newBookClient(t)
+
+	err := createBook(t, conn, map[string]any{"title": "", "tags": []any{"go", "validation"}})
+
+	violations, ok := grpcvalidation.UnwrapViolations(err)
+	require.True(t, ok)
+	validationtest.Assert(t, violations.AsError()).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrIsBlank,
+			Message:      "This value should not be blank.",
+			PropertyPath: "title",
+		},
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrTooLong,
+			Message:      "This value is too long. It should have 5 characters or less.",
+			PropertyPath: "tags[1]",
+		},
+	)
+}
+
+func TestUnaryServerInterceptor_WhenOtherError_ExpectErrorNotConverted(t *testing.T) {
+	conn := newBookClient(t)
+
+	err := createBook(t, conn, map[string]any{"title": "Book", "storageDown": true})
+
+	assert.Equal(t, codes.Unknown, status.Code(err))
+	_, ok := grpcvalidation.UnwrapViolations(err)
+	assert.False(t, ok)
+}
+
+func TestUnaryServerInterceptor_WhenValid_ExpectNoError(t *testing.T) {
+	conn := newBookClient(t)
+
+	err := createBook(t, conn, map[string]any{"title": "Book", "tags": []any{"go"}})
+
+	assert.NoError(t, err)
+}
+
+func TestError_WhenNotViolations_ExpectSameError(t *testing.T) {
+	assert.Same(t, errBookStorage, grpcvalidation.Error(errBookStorage))
+	assert.NoError(t, grpcvalidation.Error(nil))
+}
+
+func TestFromStatus_WhenNoBadRequest_ExpectEmptyList(t *testing.T) {
+	st, err := status.New(codes.InvalidArgument, "invalid").WithDetails(&errdetails.ErrorInfo{Reason: "test"})
+	require.NoError(t, err)
+
+	violations, err := grpcvalidation.FromStatus(st)
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, violations.Len())
+	_, ok := grpcvalidation.UnwrapViolations(st.Err())
+	assert.False(t, ok)
+}
+
+func TestFromStatus_WhenInvalidField_ExpectError(t *testing.T) {
+	st, err := status.New(codes.InvalidArgument, "invalid").WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: "tags["}},
+	})
+	require.NoError(t, err)
+
+	_, err = grpcvalidation.FromStatus(st)
+
+	assert.ErrorContains(t, err, "decode field violation #0")
+}
+
+func TestFromStatus_WhenUnknownReason_ExpectViolationWithCode(t *testing.T) {
+	st, err := status.New(codes.InvalidArgument, "invalid").WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "email", Description: "Email is taken.", Reason: "app: email is taken"},
+		},
+	})
+	require.NoError(t, err)
+
+	violations, err := grpcvalidation.FromStatus(st)
+
+	require.NoError(t, err)
+	validationtest.Assert(t, violations.AsError()).IsViolationList().WithOneViolation().
+		WithMessage("Email is taken.").
+		WithPropertyPath("email")
+	assert.Equal(t, "app: email is taken", violations.First().Unwrap().Error())
+}
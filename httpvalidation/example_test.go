@@ -0,0 +1,71 @@
+package httpvalidation_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/muonsoft/language"
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/httpvalidation"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/message/translations/russian"
+)
+
+type CreateBookRequest struct {
+	AuthorID int    `json:"-" path:"authorID"`
+	Title    string `json:"title"`
+}
+
+func (r CreateBookRequest) Validate(ctx context.Context, validator *validation.Validator) error {
+	return validator.Validate(ctx,
+		validation.NumberProperty[int]("authorID", r.AuthorID, it.IsPositive[int]()),
+		validation.StringProperty("title", r.Title, it.IsNotBlank()),
+	)
+}
+
+func ExampleHandlerFunc() {
+	validator, err := validation.NewValidator(validation.Translations(russian.Messages))
+	if err != nil {
+		panic(err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("POST /authors/{authorID}/books", httpvalidation.HandlerFunc(
+		func(writer http.ResponseWriter, request *http.Request, book *CreateBookRequest) {
+			// handle valid request
+			writer.WriteHeader(http.StatusCreated)
+		},
+		httpvalidation.WithValidator(validator),
+		httpvalidation.SupportedLanguages(language.English, language.Russian),
+	))
+
+	request := httptest.NewRequestWithContext(
+		context.Background(),
+		http.MethodPost,
+		"/authors/0/books",
+		strings.NewReader(`{"title":""}`),
+	)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Accept-Language", "ru")
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, request)
+
+	fmt.Println(recorder.Code, recorder.Header().Get("Content-Type"))
+	// clients can decode the problem document back into the violations
+	problem, err := httpvalidation.DecodeProblem(recorder.Body)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(problem.Title)
+	for _, violation := range problem.Violations.All() {
+		fmt.Println(violation.PropertyPath(), "-", violation.Message())
+	}
+	// Output:
+	// 422 application/problem+json
+	// Unprocessable Entity
+	// authorID - Значение должно быть положительным.
+	// title - Значение не должно быть пустым.
+}
@@ -0,0 +1,278 @@
+// Package httpvalidation contains net/http middleware to decode and validate requests.
+//
+// The middleware decodes a JSON body, query parameters, and path parameters into the value
+// of the target type, validates it, and passes it to the next handler via the request context.
+// If the request cannot be decoded or the value is not valid, then the middleware responds
+// with an RFC 9457 problem document (application/problem+json) containing the violations
+// translated into the language negotiated from the Accept-Language header.
+package httpvalidation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/muonsoft/language"
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/validator"
+	textlanguage "golang.org/x/text/language"
+)
+
+// DefaultMaxBodySize is the default limit of the request body size in bytes.
+const DefaultMaxBodySize = 1 << 20
+
+// Option is used to set up the [Middleware].
+type Option func(options *middlewareOptions)
+
+type middlewareOptions struct {
+	validator             *validation.Validator
+	languages             []language.Tag
+	matcher               textlanguage.Matcher
+	maxBodySize           int64
+	disallowUnknownFields bool
+	errorHandler          func(writer http.ResponseWriter, request *http.Request, err error)
+}
+
+// WithValidator sets the validator used to validate the decoded values.
+// By default, the singleton validator from the validator package is used.
+func WithValidator(validator *validation.Validator) Option {
+	return func(options *middlewareOptions) {
+		options.validator = validator
+	}
+}
+
+// SupportedLanguages sets the list of languages used to negotiate the language of violations
+// from the Accept-Language header. By default, only English is supported.
+// If the language is already passed via the request context (for example, by the middleware
+// from the github.com/muonsoft/language package), then it is not negotiated again.
+func SupportedLanguages(tags ...language.Tag) Option {
+	return func(options *middlewareOptions) {
+		options.languages = tags
+		options.matcher = textlanguage.NewMatcher(tags)
+	}
+}
+
+// MaxBodySize sets the limit of the request body size in bytes. The default value is [DefaultMaxBodySize].
+func MaxBodySize(size int64) Option {
+	return func(options *middlewareOptions) {
+		options.maxBodySize = size
+	}
+}
+
+// DisallowUnknownFields makes the middleware reject request bodies with the fields
+// that do not match any field of the target type.
+func DisallowUnknownFields() Option {
+	return func(options *middlewareOptions) {
+		options.disallowUnknownFields = true
+	}
+}
+
+// WithErrorHandler sets the handler for the errors that are not caused by the client
+// (for example, [validation.ConstraintError]). By default, the middleware responds with
+// the problem document with the 500 status code and without details.
+func WithErrorHandler(handler func(writer http.ResponseWriter, request *http.Request, err error)) Option {
+	return func(options *middlewareOptions) {
+		options.errorHandler = handler
+	}
+}
+
+// Middleware decodes the request into the value of type T, validates it, and passes the value
+// to the next handler via the request context. Use [FromContext] to get the value in the handler.
+//
+// The value is decoded in the following order:
+//   - the JSON body is decoded by the [encoding/json] package (empty body is skipped);
+//   - fields with the "query" tag are decoded from the query parameters;
+//   - fields with the "path" tag are decoded from the path parameters by the [http.Request.PathValue] method.
+//
+// Query and path parameters can be decoded into strings, booleans, numbers, types implementing
+// the [encoding.TextUnmarshaler] interface, pointers to them, and slices of them (from the repeated
+// query parameters). For example:
+//
+//	type ListBooksRequest struct {
+//		AuthorID int      `path:"authorID"`
+//		Page     int      `query:"page"`
+//		Tags     []string `query:"tag"`
+//	}
+//
+// If the value implements the [validation.Validatable] interface, then it is validated by
+// the [validation.Validator.ValidateIt] method. Otherwise, structs are validated by the rules
+// from the struct tags (see [validation.Struct]).
+//
+// The middleware responds with the problem document (see [Problem]):
+//   - 400 Bad Request - the body is not a valid JSON or parameters cannot be decoded;
+//   - 413 Request Entity Too Large - the body exceeds the size limit;
+//   - 415 Unsupported Media Type - the body is not of the JSON media type;
+//   - 422 Unprocessable Entity - the decoded value is not valid.
+type Middleware[T any] struct {
+	next    http.Handler
+	options middlewareOptions
+}
+
+// NewMiddleware creates the [Middleware] to decode and validate the value of type T
+// before passing the request to the next handler.
+func NewMiddleware[T any](next http.Handler, options ...Option) *Middleware[T] {
+	middleware := &Middleware[T]{
+		next: next,
+		options: middlewareOptions{
+			maxBodySize: DefaultMaxBodySize,
+		},
+	}
+	for _, setOption := range options {
+		setOption(&middleware.options)
+	}
+	if len(middleware.options.languages) == 0 {
+		SupportedLanguages(language.English)(&middleware.options)
+	}
+
+	return middleware
+}
+
+// HandlerFunc creates the [Middleware] with the handler function receiving the decoded and validated value.
+func HandlerFunc[T any](handle func(writer http.ResponseWriter, request *http.Request, value *T), options ...Option) *Middleware[T] {
+	return NewMiddleware[T](
+		http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			value, _ := FromContext[T](request.Context())
+			handle(writer, request, value)
+		}),
+		options...,
+	)
+}
+
+// ServeHTTP implements [http.Handler].
+func (middleware *Middleware[T]) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	if language.FromContext(ctx) == language.Und {
+		// the matched tag may contain extensions (e.g. "ru-u-rg-ruzzzz"), so the supported tag is used
+		_, index := textlanguage.MatchStrings(middleware.options.matcher, request.Header.Get("Accept-Language"))
+		ctx = language.WithContext(ctx, middleware.options.languages[index])
+		request = request.WithContext(ctx)
+	}
+
+	value := new(T)
+	problem, err := middleware.decode(writer, request, value)
+	if err != nil {
+		middleware.handleError(writer, request, err)
+		return
+	}
+	if problem != nil {
+		WriteProblem(writer, request, problem)
+		return
+	}
+
+	err = middleware.validate(ctx, value)
+	if err != nil {
+		violations, ok := validation.UnwrapViolations(err)
+		if !ok {
+			middleware.handleError(writer, request, err)
+			return
+		}
+		WriteProblem(writer, request, NewProblem(http.StatusUnprocessableEntity, violations))
+		return
+	}
+
+	middleware.next.ServeHTTP(writer, request.WithContext(context.WithValue(ctx, valueKey{}, value)))
+}
+
+func (middleware *Middleware[T]) decode(writer http.ResponseWriter, request *http.Request, value *T) (*Problem, error) {
+	if problem := middleware.decodeBody(writer, request, value); problem != nil {
+		return problem, nil
+	}
+
+	violations, err := decodeParameters(request, reflect.ValueOf(value).Elem(), middleware.options.getValidator())
+	if err != nil {
+		return nil, err
+	}
+	if violations.Len() > 0 {
+		return NewProblem(http.StatusBadRequest, violations), nil
+	}
+
+	return nil, nil
+}
+
+func (middleware *Middleware[T]) decodeBody(writer http.ResponseWriter, request *http.Request, value *T) *Problem {
+	if request.Body == nil || request.Body == http.NoBody || request.ContentLength == 0 {
+		return nil
+	}
+	if contentType := request.Header.Get("Content-Type"); contentType != "" && !isJSONMediaType(contentType) {
+		return &Problem{
+			Status: http.StatusUnsupportedMediaType,
+			Detail: "Request body should be of the JSON media type.",
+		}
+	}
+
+	decoder := json.NewDecoder(http.MaxBytesReader(writer, request.Body, middleware.options.maxBodySize))
+	if middleware.options.disallowUnknownFields {
+		decoder.DisallowUnknownFields()
+	}
+	err := decoder.Decode(value)
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return &Problem{
+			Status: http.StatusRequestEntityTooLarge,
+			Detail: "Request body is too large.",
+		}
+	}
+	if err != nil {
+		return &Problem{
+			Status: http.StatusBadRequest,
+			Detail: "Request body is not valid: " + err.Error(),
+		}
+	}
+
+	return nil
+}
+
+func (middleware *Middleware[T]) validate(ctx context.Context, value *T) error {
+	v := middleware.options.getValidator()
+	if validatable, ok := any(value).(validation.Validatable); ok {
+		return v.ValidateIt(ctx, validatable)
+	}
+	if reflect.TypeFor[T]().Kind() == reflect.Struct {
+		return v.ValidateStruct(ctx, value)
+	}
+
+	return nil
+}
+
+func (middleware *Middleware[T]) handleError(writer http.ResponseWriter, request *http.Request, err error) {
+	if middleware.options.errorHandler != nil {
+		middleware.options.errorHandler(writer, request, err)
+		return
+	}
+
+	WriteProblem(writer, request, &Problem{Status: http.StatusInternalServerError})
+}
+
+func (options *middlewareOptions) getValidator() *validation.Validator {
+	if options.validator != nil {
+		return options.validator
+	}
+
+	return validator.Default()
+}
+
+type valueKey struct{}
+
+// FromContext returns the value decoded and validated by the [Middleware].
+// It returns false if the value of type T is not passed via the context.
+func FromContext[T any](ctx context.Context) (*T, bool) {
+	value, ok := ctx.Value(valueKey{}).(*T)
+	return value, ok
+}
+
+func isJSONMediaType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
@@ -0,0 +1,297 @@
+package httpvalidation_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/muonsoft/language"
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/httpvalidation"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/message/translations/russian"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type createBookRequest struct {
+	AuthorID int      `json:"-" path:"authorID"`
+	DryRun   bool     `json:"-" query:"dryRun"`
+	Tags     []string `json:"-" query:"tag"`
+	Title    string   `json:"title"`
+}
+
+func (r createBookRequest) Validate(ctx context.Context, validator *validation.Validator) error {
+	return validator.Validate(ctx,
+		validation.NumberProperty[int]("authorID", r.AuthorID, it.IsPositive[int]()),
+		validation.StringProperty("title", r.Title, it.IsNotBlank()),
+		validation.EachStringProperty("tag", r.Tags, it.HasMaxLength(5)),
+	)
+}
+
+type taggedRequest struct {
+	Title string `json:"title" validate:"notblank"`
+}
+
+type unsupportedRequest struct {
+	Filter map[string]string `query:"filter"`
+}
+
+type invalidConstraintRequest struct{}
+
+func (r invalidConstraintRequest) Validate(ctx context.Context, validator *validation.Validator) error {
+	return validator.CreateConstraintError("test", "invalid constraint")
+}
+
+func newTestValidator(t *testing.T) *validation.Validator {
+	t.Helper()
+	v, err := validation.NewValidator(validation.Translations(russian.Messages))
+	require.NoError(t, err)
+	return v
+}
+
+func serve(t *testing.T, handler http.Handler, request *http.Request) *httptest.ResponseRecorder {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.Handle("POST /authors/{authorID}/books", handler)
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func newRequest(url, body string) *http.Request {
+	request := httptest.NewRequestWithContext(context.Background(), http.MethodPost, url, strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/json")
+	return request
+}
+
+func TestMiddleware_WhenValidRequest_ExpectDecodedValuePassedToHandler(t *testing.T) {
+	var got *createBookRequest
+	handler := httpvalidation.HandlerFunc(
+		func(writer http.ResponseWriter, request *http.Request, value *createBookRequest) {
+			got = value
+			writer.WriteHeader(http.StatusCreated)
+		},
+		httpvalidation.WithValidator(newTestValidator(t)),
+	)
+
+	recorder := serve(t, handler, newRequest("/authors/1/books?dryRun=true&tag=a&tag=b", `{"title":"Book"}`))
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+	assert.Equal(t, &createBookRequest{AuthorID: 1, DryRun: true, Tags: []string{"a", "b"}, Title: "Book"}, got)
+}
+
+func TestMiddleware_WhenInvalidValue_ExpectProblemWithTranslatedViolations(t *testing.T) {
+	handler := httpvalidation.NewMiddleware[createBookRequest](
+		http.NotFoundHandler(),
+		httpvalidation.WithValidator(newTestValidator(t)),
+		httpvalidation.SupportedLanguages(language.English, language.Russian),
+	)
+	request := newRequest("/authors/0/books?tag=abcdef", `{"title":""}`)
+	request.Header.Set("Accept-Language", "ru")
+
+	recorder := serve(t, handler, request)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+	assert.Equal(t, httpvalidation.ProblemMediaType, recorder.Header().Get("Content-Type"))
+	assert.Equal(t, "ru", recorder.Header().Get("Content-Language"))
+	assert.JSONEq(t, `{
+		"type": "about:blank",
+		"title": "Unprocessable Entity",
+		"status": 422,
+		"violations": [
+			{
+				"error": "is not positive",
+				"message": "Значение должно быть положительным.",
+				"messageTemplate": "This value should be positive.",
+				"parameters": [{"key": "{{ comparedValue }}", "value": "0"}, {"key": "{{ value }}", "value": "0"}],
+				"propertyPath": "authorID"
+			},
+			{
+				"error": "is blank",
+				"message": "Значение не должно быть пустым.",
+				"messageTemplate": "This value should not be blank.",
+				"propertyPath": "title"
+			},
+			{
+				"error": "is too long",
+				"message": "Значение слишком длинное. Должно быть равно 5 символам или меньше.",
+				"messageTemplate": "This value is too long. It should have {{ limit }} character(s) or less.",
+				"parameters": [
+					{"key": "{{ value }}", "value": "\"abcdef\""},
+					{"key": "{{ length }}", "value": "6"},
+					{"key": "{{ limit }}", "value": "5"}
+				],
+				"pluralCount": 5,
+				"propertyPath": "tag[0]"
+			}
+		]
+	}`, recorder.Body.String())
+}
+
+func TestMiddleware_WhenAcceptLanguageWithRegion_ExpectSupportedLanguage(t *testing.T) {
+	handler := httpvalidation.NewMiddleware[createBookRequest](
+		http.NotFoundHandler(),
+		httpvalidation.WithValidator(newTestValidator(t)),
+		httpvalidation.SupportedLanguages(language.English, language.Russian),
+	)
+	request := newRequest("/authors/0/books", `{"title":""}`)
+	request.Header.Set("Accept-Language", "ru-RU")
+
+	recorder := serve(t, handler, request)
+
+	assert.Equal(t, "ru", recorder.Header().Get("Content-Language"))
+	assert.Contains(t, recorder.Body.String(), "Значение не должно быть пустым.")
+}
+
+func TestMiddleware_WhenLanguageInContext_ExpectLanguageNotNegotiated(t *testing.T) {
+	handler := language.NewMiddleware(
+		httpvalidation.NewMiddleware[createBookRequest](
+			http.NotFoundHandler(),
+			httpvalidation.WithValidator(newTestValidator(t)),
+		),
+		language.SupportedLanguages(language.English, language.Russian),
+	)
+	request := newRequest("/authors/1/books", `{"title":""}`)
+	request.Header.Set("Accept-Language", "ru")
+
+	recorder := serve(t, handler, request)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "Значение не должно быть пустым.")
+}
+
+func TestMiddleware_WhenStructTags_ExpectValidatedByStructTags(t *testing.T) {
+	handler := httpvalidation.NewMiddleware[taggedRequest](http.NotFoundHandler())
+
+	recorder := serve(t, handler, newRequest("/authors/1/books", `{"title":""}`))
+
+	assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"propertyPath":"title"`)
+}
+
+func TestMiddleware_WhenInvalidParameter_ExpectBadRequestWithViolation(t *testing.T) {
+	handler := httpvalidation.NewMiddleware[createBookRequest](http.NotFoundHandler())
+
+	recorder := serve(t, handler, newRequest("/authors/abc/books?dryRun=maybe", `{"title":"Book"}`))
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.JSONEq(t, `{
+		"type": "about:blank",
+		"title": "Bad Request",
+		"status": 400,
+		"violations": [
+			{
+				"error": "invalid type",
+				"message": "This value should be of type boolean.",
+				"messageTemplate": "This value should be of type {{ type }}.",
+				"parameters": [{"key": "{{ type }}", "value": "boolean"}, {"key": "{{ value }}", "value": "\"maybe\""}],
+				"propertyPath": "dryRun"
+			},
+			{
+				"error": "invalid type",
+				"message": "This value should be of type integer.",
+				"messageTemplate": "This value should be of type {{ type }}.",
+				"parameters": [{"key": "{{ type }}", "value": "integer"}, {"key": "{{ value }}", "value": "\"abc\""}],
+				"propertyPath": "authorID"
+			}
+		]
+	}`, recorder.Body.String())
+}
+
+func TestMiddleware_WhenInvalidBody_ExpectBadRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		options     []httpvalidation.Option
+		wantStatus  int
+	}{
+		{
+			name:        "invalid JSON",
+			contentType: "application/json",
+			body:        `{`,
+			wantStatus:  http.StatusBadRequest,
+		},
+		{
+			name:        "unknown field",
+			contentType: "application/json",
+			body:        `{"title":"Book","author":"Alice"}`,
+			options:     []httpvalidation.Option{httpvalidation.DisallowUnknownFields()},
+			wantStatus:  http.StatusBadRequest,
+		},
+		{
+			name:        "unsupported media type",
+			contentType: "text/plain",
+			body:        `{"title":"Book"}`,
+			wantStatus:  http.StatusUnsupportedMediaType,
+		},
+		{
+			name:        "too large",
+			contentType: "application/merge-patch+json",
+			body:        `{"title":"Book"}`,
+			options:     []httpvalidation.Option{httpvalidation.MaxBodySize(5)},
+			wantStatus:  http.StatusRequestEntityTooLarge,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := httpvalidation.NewMiddleware[createBookRequest](http.NotFoundHandler(), test.options...)
+			request := newRequest("/authors/1/books", test.body)
+			request.Header.Set("Content-Type", test.contentType)
+
+			recorder := serve(t, handler, request)
+
+			assert.Equal(t, test.wantStatus, recorder.Code)
+			assert.Equal(t, httpvalidation.ProblemMediaType, recorder.Header().Get("Content-Type"))
+			assert.Contains(t, recorder.Body.String(), `"detail":`)
+		})
+	}
+}
+
+func TestMiddleware_WhenEmptyBody_ExpectOnlyParametersDecoded(t *testing.T) {
+	handler := httpvalidation.NewMiddleware[createBookRequest](http.NotFoundHandler())
+
+	recorder := serve(t, handler, newRequest("/authors/1/books", ""))
+
+	assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"propertyPath":"title"`)
+}
+
+func TestMiddleware_WhenUnsupportedParameterType_ExpectErrorHandlerCalled(t *testing.T) {
+	var handledErr error
+	handler := httpvalidation.NewMiddleware[unsupportedRequest](
+		http.NotFoundHandler(),
+		httpvalidation.WithErrorHandler(func(writer http.ResponseWriter, request *http.Request, err error) {
+			handledErr = err
+			writer.WriteHeader(http.StatusInternalServerError)
+		}),
+	)
+
+	recorder := serve(t, handler, newRequest("/authors/1/books?filter=a", ""))
+
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	assert.EqualError(
+		t,
+		handledErr,
+		`decode query parameter "filter" into field "Filter": unsupported type "map[string]string"`,
+	)
+}
+
+func TestMiddleware_WhenConstraintError_ExpectInternalServerError(t *testing.T) {
+	handler := httpvalidation.NewMiddleware[invalidConstraintRequest](http.NotFoundHandler())
+
+	recorder := serve(t, handler, newRequest("/authors/1/books", ""))
+
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	assert.JSONEq(t, `{"type":"about:blank","title":"Internal Server Error","status":500}`, recorder.Body.String())
+}
+
+func TestFromContext_WhenNoValue_ExpectFalse(t *testing.T) {
+	value, ok := httpvalidation.FromContext[createBookRequest](context.Background())
+
+	assert.False(t, ok)
+	assert.Nil(t, value)
+}
@@ -0,0 +1,162 @@
+package httpvalidation
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/muonsoft/validation"
+)
+
+var errUnsupportedType = errors.New("unsupported type")
+
+var textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+
+// parameterSource is a source of the request parameters: the query or the path.
+type parameterSource struct {
+	tag    string
+	values func(request *http.Request, name string) []string
+}
+
+var parameterSources = []parameterSource{
+	{
+		tag: "query",
+		values: func(request *http.Request, name string) []string {
+			return request.URL.Query()[name]
+		},
+	},
+	{
+		tag: "path",
+		values: func(request *http.Request, name string) []string {
+			if value := request.PathValue(name); value != "" {
+				return []string{value}
+			}
+			return nil
+		},
+	},
+}
+
+// decodeParameters decodes the query and path parameters into the fields of the struct
+// marked by the "query" and "path" tags. Values that cannot be converted into the type
+// of the field are returned as violations at the name of the parameter.
+func decodeParameters(request *http.Request, value reflect.Value, validator *validation.Validator) (*validation.ViolationList, error) {
+	violations := validator.BuildViolationList(request.Context())
+	if value.Kind() != reflect.Struct {
+		return violations.Create(), nil
+	}
+
+	for _, source := range parameterSources {
+		err := decodeParametersFrom(request, source, value, violations)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return violations.Create(), nil
+}
+
+func decodeParametersFrom(
+	request *http.Request,
+	source parameterSource,
+	value reflect.Value,
+	violations *validation.ViolationListBuilder,
+) error {
+	t := value.Type()
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			if err := decodeParametersFrom(request, source, value.Field(i), violations); err != nil {
+				return err
+			}
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get(source.tag), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		values := source.values(request, name)
+		if len(values) == 0 {
+			continue
+		}
+
+		typeName, err := setParameter(value.Field(i), values)
+		if errors.Is(err, errUnsupportedType) {
+			return fmt.Errorf(`decode %s parameter "%s" into field "%s": %w`, source.tag, name, field.Name, err)
+		}
+		if err != nil {
+			violations.BuildViolation(validation.ErrInvalidType, validation.ErrInvalidType.Message()).
+				WithParameter("{{ type }}", typeName).
+				WithParameter("{{ value }}", strconv.Quote(values[0])).
+				AtProperty(name).
+				Add()
+		}
+	}
+
+	return nil
+}
+
+// setParameter sets the parameter values into the field. It returns the name of the expected
+// type to be used in the violation message.
+func setParameter(field reflect.Value, values []string) (string, error) {
+	if field.Kind() == reflect.Slice && !field.Type().Implements(textUnmarshalerType) &&
+		!reflect.PointerTo(field.Type()).Implements(textUnmarshalerType) {
+		slice := reflect.MakeSlice(field.Type(), len(values), len(values))
+		for i, value := range values {
+			if typeName, err := setValue(slice.Index(i), value); err != nil {
+				return typeName, err
+			}
+		}
+		field.Set(slice)
+		return "array", nil
+	}
+
+	return setValue(field, values[0])
+}
+
+func setValue(field reflect.Value, value string) (string, error) {
+	if field.Kind() == reflect.Pointer {
+		element := reflect.New(field.Type().Elem())
+		typeName, err := setValue(element.Elem(), value)
+		if err != nil {
+			return typeName, err
+		}
+		field.Set(element)
+		return typeName, nil
+	}
+	if field.CanAddr() && field.Addr().Type().Implements(textUnmarshalerType) {
+		unmarshaler, _ := field.Addr().Interface().(encoding.TextUnmarshaler)
+		return "string", unmarshaler.UnmarshalText([]byte(value))
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+		return "string", nil
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		field.SetBool(b)
+		return "boolean", err
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		field.SetInt(i)
+		return "integer", err
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(value, 10, field.Type().Bits())
+		field.SetUint(u)
+		return "integer", err
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(value, field.Type().Bits())
+		field.SetFloat(f)
+		return "number", err
+	default:
+		return "", fmt.Errorf(`%w "%s"`, errUnsupportedType, field.Type().String())
+	}
+}
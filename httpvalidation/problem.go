@@ -0,0 +1,168 @@
+package httpvalidation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/muonsoft/language"
+	"github.com/muonsoft/validation"
+)
+
+// ProblemMediaType is the media type of the problem document defined by RFC 9457.
+const ProblemMediaType = "application/problem+json"
+
+var errNotProblem = errors.New("response is not a problem document")
+
+// Problem is the problem details document defined by RFC 9457. The violations of the request
+// are passed via the "violations" extension member. Each violation is encoded with the error code,
+// the translated message, the message template, the template parameters, the plural count,
+// and the property path (see [validation.ViolationData]):
+//
+//	{
+//	    "type": "about:blank",
+//	    "title": "Unprocessable Entity",
+//	    "status": 422,
+//	    "violations": [
+//	        {
+//	            "error": "is too long",
+//	            "message": "This value is too long. It should have 5 characters or less.",
+//	            "messageTemplate": "This value is too long. It should have {{ limit }} character(s) or less.",
+//	            "parameters": [{"key": "{{ limit }}", "value": "5"}],
+//	            "pluralCount": 5,
+//	            "propertyPath": "tags[0]"
+//	        }
+//	    ]
+//	}
+//
+// If the list of violations is truncated (see [validation.ViolationList.IsTruncated]), then
+// the "violationsTruncated" extension member is set to true.
+//
+// The document can be decoded back by [DecodeProblem] or [ReadProblem], so the clients
+// can reconstruct the [validation.ViolationList] from the problem response.
+type Problem struct {
+	// Type is a URI reference that identifies the problem type. The default value is "about:blank".
+	Type string
+	// Title is a short summary of the problem type. The default value is the text of the status code.
+	Title string
+	// Status is the HTTP status code.
+	Status int
+	// Detail is an explanation specific to this occurrence of the problem.
+	Detail string
+	// Instance is a URI reference that identifies the specific occurrence of the problem.
+	Instance string
+	// Violations is a list of violations of the request.
+	Violations *validation.ViolationList
+}
+
+// NewProblem creates the [Problem] with the status code and the list of violations.
+func NewProblem(status int, violations *validation.ViolationList) *Problem {
+	return &Problem{Status: status, Violations: violations}
+}
+
+type problemDocument struct {
+	Type       string                      `json:"type"`
+	Title      string                      `json:"title"`
+	Status     int                         `json:"status"`
+	Detail     string                      `json:"detail,omitempty"`
+	Instance   string                      `json:"instance,omitempty"`
+	Violations []*validation.ViolationData `json:"violations,omitempty"`
+	// ViolationsTruncated is set if the list of violations is truncated.
+	ViolationsTruncated bool `json:"violationsTruncated,omitempty"`
+}
+
+// MarshalJSON encodes the problem into the RFC 9457 document. The default values
+// of the type and the title are used if they are empty.
+func (problem *Problem) MarshalJSON() ([]byte, error) {
+	document := problemDocument{
+		Type:     problem.Type,
+		Title:    problem.Title,
+		Status:   problem.Status,
+		Detail:   problem.Detail,
+		Instance: problem.Instance,
+	}
+	if document.Type == "" {
+		document.Type = "about:blank"
+	}
+	if document.Title == "" {
+		document.Title = http.StatusText(problem.Status)
+	}
+
+	if problem.Violations.Len() > 0 {
+		document.Violations = validation.NewViolationListData(problem.Violations).Violations
+	}
+	document.ViolationsTruncated = problem.Violations.IsTruncated()
+
+	return json.Marshal(document)
+}
+
+// UnmarshalJSON decodes the problem from the RFC 9457 document. Violations are decoded
+// by the [validation.ViolationData.Violation] method, so the underlying errors of the violations
+// are resolved to the static errors having the same code (for example, [validation.ErrIsBlank]).
+func (problem *Problem) UnmarshalJSON(data []byte) error {
+	var document problemDocument
+	if err := json.Unmarshal(data, &document); err != nil {
+		return err
+	}
+
+	violations, err := (&validation.ViolationListData{
+		Violations: document.Violations,
+		Truncated:  document.ViolationsTruncated,
+	}).ViolationList()
+	if err != nil {
+		return err
+	}
+
+	*problem = Problem{
+		Type:       document.Type,
+		Title:      document.Title,
+		Status:     document.Status,
+		Detail:     document.Detail,
+		Instance:   document.Instance,
+		Violations: violations,
+	}
+
+	return nil
+}
+
+// DecodeProblem decodes the problem document from the reader.
+func DecodeProblem(reader io.Reader) (*Problem, error) {
+	problem := &Problem{}
+	if err := json.NewDecoder(reader).Decode(problem); err != nil {
+		return nil, fmt.Errorf("decode problem: %w", err)
+	}
+
+	return problem, nil
+}
+
+// ReadProblem decodes the problem document from the body of the HTTP response.
+// It returns an error if the response is not of the [ProblemMediaType] media type.
+// The body of the response is not closed.
+func ReadProblem(response *http.Response) (*Problem, error) {
+	mediaType, _, _ := mime.ParseMediaType(response.Header.Get("Content-Type"))
+	if mediaType != ProblemMediaType {
+		return nil, fmt.Errorf(`%w: unexpected media type "%s"`, errNotProblem, mediaType)
+	}
+
+	return DecodeProblem(response.Body)
+}
+
+// WriteProblem writes the problem document into the response. The language of the violations
+// passed via the request context is written into the Content-Language header.
+func WriteProblem(writer http.ResponseWriter, request *http.Request, problem *Problem) {
+	data, err := json.Marshal(problem)
+	if err != nil {
+		http.Error(writer, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	writer.Header().Set("Content-Type", ProblemMediaType)
+	if tag := language.FromContext(request.Context()); tag != language.Und {
+		writer.Header().Set("Content-Language", tag.String())
+	}
+	writer.WriteHeader(problem.Status)
+	_, _ = writer.Write(data)
+}
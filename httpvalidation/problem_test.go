@@ -0,0 +1,193 @@
+package httpvalidation_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/httpvalidation"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/validationtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProblem_MarshalJSON_WhenViolations_ExpectProblemDocument(t *testing.T) {
+	err := newTestValidator(t).Validate(
+		context.Background(),
+		validation.StringProperty("title", "", it.IsNotBlank()),
+		validation.StringProperty("name", "abc", it.HasMaxLength(2)),
+	)
+	violations, ok := validation.UnwrapViolations(err)
+	require.True(t, ok)
+
+	data, err := json.Marshal(&httpvalidation.Problem{
+		Status:     http.StatusUnprocessableEntity,
+		Detail:     "The request is not valid.",
+		Instance:   "/books/1",
+		Violations: violations,
+	})
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"type": "about:blank",
+		"title": "Unprocessable Entity",
+		"status": 422,
+		"detail": "The request is not valid.",
+		"instance": "/books/1",
+		"violations": [
+			{
+				"error": "is blank",
+				"message": "This value should not be blank.",
+				"messageTemplate": "This value should not be blank.",
+				"propertyPath": "title"
+			},
+			{
+				"error": "is too long",
+				"message": "This value is too long. It should have 2 characters or less.",
+				"messageTemplate": "This value is too long. It should have {{ limit }} character(s) or less.",
+				"parameters": [
+					{"key": "{{ value }}", "value": "\"abc\""},
+					{"key": "{{ length }}", "value": "3"},
+					{"key": "{{ limit }}", "value": "2"}
+				],
+				"pluralCount": 2,
+				"propertyPath": "name"
+			}
+		]
+	}`, string(data))
+}
+
+func TestProblem_MarshalJSON_WhenViolationWithPayload_ExpectPayloadInDocument(t *testing.T) {
+	err := newTestValidator(t).Validate(
+		context.Background(),
+		validation.StringProperty("title", "", it.IsNotBlank().WithPayload(map[string]string{"docs": "/docs/title"})),
+	)
+	violations, ok := validation.UnwrapViolations(err)
+	require.True(t, ok)
+
+	data, err := json.Marshal(httpvalidation.NewProblem(http.StatusUnprocessableEntity, violations))
+
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"payload":{"docs":"/docs/title"}`)
+	problem, err := httpvalidation.DecodeProblem(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"docs": "/docs/title"}, validation.PayloadOf(problem.Violations.First()))
+}
+
+func TestProblem_MarshalJSON_WhenNoViolations_ExpectViolationsOmitted(t *testing.T) {
+	data, err := json.Marshal(&httpvalidation.Problem{
+		Type:   "https://example.com/problems/out-of-credit",
+		Title:  "You do not have enough credit.",
+		Status: http.StatusForbidden,
+	})
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"type": "https://example.com/problems/out-of-credit",
+		"title": "You do not have enough credit.",
+		"status": 403
+	}`, string(data))
+}
+
+func TestDecodeProblem_WhenEncodedViolations_ExpectViolationsReconstructed(t *testing.T) {
+	err := newTestValidator(t).Validate(
+		context.Background(),
+		validation.StringProperty("title", "", it.IsNotBlank()),
+		validation.AtProperty("tags", validation.EachString([]string{"abc"}, it.HasMaxLength(2))),
+	)
+	violations, ok := validation.UnwrapViolations(err)
+	require.True(t, ok)
+	data, err := json.Marshal(httpvalidation.NewProblem(http.StatusUnprocessableEntity, violations))
+	require.NoError(t, err)
+
+	problem, err := httpvalidation.DecodeProblem(strings.NewReader(string(data)))
+
+	require.NoError(t, err)
+	assert.Equal(t, "about:blank", problem.Type)
+	assert.Equal(t, "Unprocessable Entity", problem.Title)
+	assert.Equal(t, http.StatusUnprocessableEntity, problem.Status)
+	validationtest.Assert(t, problem.Violations.AsError()).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrIsBlank,
+			Message:      "This value should not be blank.",
+			PropertyPath: "title",
+		},
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrTooLong,
+			Message:      "This value is too long. It should have 2 characters or less.",
+			PropertyPath: "tags[0]",
+		},
+	)
+	violation := problem.Violations.Last().Violation()
+	assert.Equal(t, validation.ErrTooLong.Message(), violation.MessageTemplate())
+	assert.Equal(t, []validation.TemplateParameter{
+		{Key: "{{ value }}", Value: `"abc"`},
+		{Key: "{{ length }}", Value: "3"},
+		{Key: "{{ limit }}", Value: "2"},
+	}, violation.Parameters())
+	assert.False(t, errors.Is(violation, validation.ErrIsBlank))
+	assert.Equal(t, `violation at "tags[0]": "This value is too long. It should have 2 characters or less."`, violation.Error())
+}
+
+func TestDecodeProblem_WhenTruncatedViolations_ExpectTruncatedFlagRestored(t *testing.T) {
+	err := newTestValidator(t).WithMaxViolations(1).Validate(
+		context.Background(),
+		validation.EachString([]string{"", ""}, it.IsNotBlank()),
+	)
+	violations, ok := validation.UnwrapViolations(err)
+	require.True(t, ok)
+	data, err := json.Marshal(httpvalidation.NewProblem(http.StatusUnprocessableEntity, violations))
+	require.NoError(t, err)
+
+	problem, err := httpvalidation.DecodeProblem(strings.NewReader(string(data)))
+
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"violationsTruncated":true`)
+	assert.Equal(t, 1, problem.Violations.Len())
+	assert.True(t, problem.Violations.IsTruncated())
+}
+
+func TestDecodeProblem_WhenInvalidPropertyPath_ExpectError(t *testing.T) {
+	_, err := httpvalidation.DecodeProblem(strings.NewReader(
+		`{"status":422,"violations":[{"error":"is blank","message":"","propertyPath":"a["}]}`,
+	))
+
+	assert.ErrorContains(t, err, `decode problem: decode violation #0: decode property path "a["`)
+}
+
+func TestReadProblem_WhenProblemResponse_ExpectProblem(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/", nil)
+	httpvalidation.WriteProblem(recorder, request, &httpvalidation.Problem{
+		Status: http.StatusBadRequest,
+		Detail: "Request body is too large.",
+	})
+
+	problem, err := httpvalidation.ReadProblem(recorder.Result())
+
+	require.NoError(t, err)
+	assert.Equal(t, &httpvalidation.Problem{
+		Type:       "about:blank",
+		Title:      "Bad Request",
+		Status:     http.StatusBadRequest,
+		Detail:     "Request body is too large.",
+		Violations: validation.NewViolationList(),
+	}, problem)
+}
+
+func TestReadProblem_WhenNotProblemResponse_ExpectError(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	recorder.Header().Set("Content-Type", "application/json")
+	recorder.WriteHeader(http.StatusOK)
+
+	_, err := httpvalidation.ReadProblem(recorder.Result())
+
+	assert.EqualError(t, err, `response is not a problem document: unexpected media type "application/json"`)
+}
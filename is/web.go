@@ -1,7 +1,7 @@
 package is
 
 import (
-	"net"
+	"net/netip"
 	"net/url"
 	"regexp"
 	"strings"
@@ -21,6 +21,19 @@ func HTML5Email(value string) bool {
 	return html5EmailRegex.MatchString(value)
 }
 
+// StrictEmail checks that a value is a valid email address according to the addr-spec grammar
+// of RFC 5322. See [github.com/muonsoft/validation/validate.Email] for options.
+func StrictEmail(value string, options ...func(*validate.EmailOptions)) bool {
+	return validate.Email(value, options...) == nil
+}
+
+// IDNEmail checks that a value is a valid internationalized email address (RFC 6531).
+// See [github.com/muonsoft/validation/validate.IDNEmail] for options.
+func IDNEmail(value string, options ...func(*validate.IDNOptions)) bool {
+	_, err := validate.IDNEmail(value, options...)
+	return err == nil
+}
+
 // URL is used to validate that value is a valid URL string. You can use a list of restrictions
 // to additionally check for a restricted set of URLs. By default, if no restrictions are passed,
 // the function checks for the http:// and https:// schemas.
@@ -37,19 +50,19 @@ func URL(value string, restrictions ...func(u *url.URL) error) bool {
 
 // IP checks that a value is a valid IP address (IPv4 or IPv6). You can use a list
 // of restrictions to additionally check for a restricted range of IPs.
-func IP(value string, restrictions ...func(ip net.IP) error) bool {
+func IP(value string, restrictions ...func(ip netip.Addr) error) bool {
 	return validate.IP(value, restrictions...) == nil
 }
 
 // IPv4 checks that a value is a valid IPv4 address. You can use a list
 // of restrictions to additionally check for a restricted range of IPs.
-func IPv4(value string, restrictions ...func(ip net.IP) error) bool {
+func IPv4(value string, restrictions ...func(ip netip.Addr) error) bool {
 	return validate.IPv4(value, restrictions...) == nil
 }
 
 // IPv6 checks that a value is a valid IPv6 address. You can use a list
 // of restrictions to additionally check for a restricted range of IPs.
-func IPv6(value string, restrictions ...func(ip net.IP) error) bool {
+func IPv6(value string, restrictions ...func(ip netip.Addr) error) bool {
 	return validate.IPv6(value, restrictions...) == nil
 }
 
@@ -74,6 +87,13 @@ func Hostname(value string) bool {
 	return hostnameRegex.MatchString(value) && len(strings.ReplaceAll(value, ".", "")) <= 255
 }
 
+// IDNHostname checks that a value is a valid internationalized hostname according to
+// IDNA 2008 and UTS #46. See [github.com/muonsoft/validation/validate.IDNHostname] for options.
+func IDNHostname(value string, options ...func(*validate.IDNOptions)) bool {
+	_, err := validate.IDNHostname(value, options...)
+	return err == nil
+}
+
 // StrictHostname checks that a value is a valid hostname. Beside checks from Hostname function
 // it checks that hostname is fully qualified and include its top-level domain name (TLD).
 // For instance, example.com is valid but example is not.
@@ -18,6 +18,8 @@ type NotBlankConstraint[T comparable] struct {
 	err               error
 	messageTemplate   string
 	messageParameters validation.TemplateParameterList
+	severity          validation.Severity
+	payload           any
 }
 
 // IsNotBlank creates a [NotBlankConstraint] for checking that value is not empty.
@@ -57,6 +59,20 @@ func (c NotBlankConstraint[T]) WhenGroups(groups ...string) NotBlankConstraint[T
 	return c
 }
 
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c NotBlankConstraint[T]) WithSeverity(severity validation.Severity) NotBlankConstraint[T] {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c NotBlankConstraint[T]) WithPayload(payload any) NotBlankConstraint[T] {
+	c.payload = payload
+	return c
+}
+
 // WithError overrides default error for produced violation.
 func (c NotBlankConstraint[T]) WithError(err error) NotBlankConstraint[T] {
 	c.err = err
@@ -71,6 +87,11 @@ func (c NotBlankConstraint[T]) WithMessage(template string, parameters ...valida
 	return c
 }
 
+// Describe returns the describable form of the constraint (see [validation.DescribableConstraint]).
+func (c NotBlankConstraint[T]) Describe() validation.ConstraintDescription {
+	return validation.ConstraintDescription{Required: !c.isIgnored && !c.allowNil}
+}
+
 func (c NotBlankConstraint[T]) ValidateNil(ctx context.Context, validator *validation.Validator, isNil bool) error {
 	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) {
 		return nil
@@ -156,6 +177,8 @@ func (c NotBlankConstraint[T]) ValidateTime(ctx context.Context, validator *vali
 
 func (c NotBlankConstraint[T]) newViolation(ctx context.Context, validator *validation.Validator) validation.Violation {
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithParameters(c.messageParameters...).
 		Create()
 }
@@ -174,6 +197,8 @@ type BlankConstraint[T comparable] struct {
 	err               error
 	messageTemplate   string
 	messageParameters validation.TemplateParameterList
+	severity          validation.Severity
+	payload           any
 }
 
 // IsBlank creates a [BlankConstraint] for checking that value is empty.
@@ -207,6 +232,20 @@ func (c BlankConstraint[T]) WhenGroups(groups ...string) BlankConstraint[T] {
 	return c
 }
 
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c BlankConstraint[T]) WithSeverity(severity validation.Severity) BlankConstraint[T] {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c BlankConstraint[T]) WithPayload(payload any) BlankConstraint[T] {
+	c.payload = payload
+	return c
+}
+
 // WithError overrides default error for produced violation.
 func (c BlankConstraint[T]) WithError(err error) BlankConstraint[T] {
 	c.err = err
@@ -275,6 +314,8 @@ func (c BlankConstraint[T]) ValidateTime(ctx context.Context, validator *validat
 
 func (c BlankConstraint[T]) newViolation(ctx context.Context, validator *validation.Validator) validation.Violation {
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithParameters(c.messageParameters...).
 		Create()
 }
@@ -292,6 +333,8 @@ type NotNilConstraint[T comparable] struct {
 	err               error
 	messageTemplate   string
 	messageParameters validation.TemplateParameterList
+	severity          validation.Severity
+	payload           any
 }
 
 // IsNotNil creates a [NotNilConstraint] to check that a value is not strictly equal to nil.
@@ -325,6 +368,20 @@ func (c NotNilConstraint[T]) WhenGroups(groups ...string) NotNilConstraint[T] {
 	return c
 }
 
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c NotNilConstraint[T]) WithSeverity(severity validation.Severity) NotNilConstraint[T] {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c NotNilConstraint[T]) WithPayload(payload any) NotNilConstraint[T] {
+	c.payload = payload
+	return c
+}
+
 // WithError overrides default error for produced violation.
 func (c NotNilConstraint[T]) WithError(err error) NotNilConstraint[T] {
 	c.err = err
@@ -339,6 +396,11 @@ func (c NotNilConstraint[T]) WithMessage(template string, parameters ...validati
 	return c
 }
 
+// Describe returns the describable form of the constraint (see [validation.DescribableConstraint]).
+func (c NotNilConstraint[T]) Describe() validation.ConstraintDescription {
+	return validation.ConstraintDescription{Required: !c.isIgnored}
+}
+
 func (c NotNilConstraint[T]) ValidateNil(ctx context.Context, validator *validation.Validator, isNil bool) error {
 	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || !isNil {
 		return nil
@@ -369,6 +431,8 @@ func (c NotNilConstraint[T]) ValidateTime(ctx context.Context, validator *valida
 
 func (c NotNilConstraint[T]) newViolation(ctx context.Context, validator *validation.Validator) validation.Violation {
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithParameters(c.messageParameters...).
 		Create()
 }
@@ -386,6 +450,8 @@ type NilConstraint[T comparable] struct {
 	err               error
 	messageTemplate   string
 	messageParameters validation.TemplateParameterList
+	severity          validation.Severity
+	payload           any
 }
 
 // IsNil creates a [NilConstraint] to check that a value is strictly equal to nil.
@@ -419,6 +485,20 @@ func (c NilConstraint[T]) WhenGroups(groups ...string) NilConstraint[T] {
 	return c
 }
 
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c NilConstraint[T]) WithSeverity(severity validation.Severity) NilConstraint[T] {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c NilConstraint[T]) WithPayload(payload any) NilConstraint[T] {
+	c.payload = payload
+	return c
+}
+
 // WithError overrides default error for produced violation.
 func (c NilConstraint[T]) WithError(err error) NilConstraint[T] {
 	c.err = err
@@ -463,6 +543,8 @@ func (c NilConstraint[T]) ValidateTime(ctx context.Context, validator *validatio
 
 func (c NilConstraint[T]) newViolation(ctx context.Context, validator *validation.Validator) validation.Violation {
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithParameters(c.messageParameters...).
 		Create()
 }
@@ -480,6 +562,8 @@ type BoolConstraint struct {
 	err               error
 	messageTemplate   string
 	messageParameters validation.TemplateParameterList
+	severity          validation.Severity
+	payload           any
 }
 
 // IsTrue creates a [BoolConstraint] to check that a value is not strictly equal to true.
@@ -513,6 +597,20 @@ func (c BoolConstraint) WhenGroups(groups ...string) BoolConstraint {
 	return c
 }
 
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c BoolConstraint) WithSeverity(severity validation.Severity) BoolConstraint {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c BoolConstraint) WithPayload(payload any) BoolConstraint {
+	c.payload = payload
+	return c
+}
+
 // WithError overrides default error for produced violation.
 func (c BoolConstraint) WithError(err error) BoolConstraint {
 	c.err = err
@@ -533,6 +631,8 @@ func (c BoolConstraint) ValidateBool(ctx context.Context, validator *validation.
 	}
 
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithParameters(c.messageParameters...).
 		Create()
 }
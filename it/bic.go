@@ -30,6 +30,8 @@ type BICConstraint struct {
 	ibanMessageTemplate   string
 	messageParameters     validation.TemplateParameterList
 	ibanMessageParameters validation.TemplateParameterList
+	severity              validation.Severity
+	payload               any
 }
 
 // IsBIC validates whether the value is a valid Business Identifier Code (BIC / SWIFT).
@@ -95,6 +97,20 @@ func (c BICConstraint) WhenGroups(groups ...string) BICConstraint {
 	return c
 }
 
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c BICConstraint) WithSeverity(severity validation.Severity) BICConstraint {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c BICConstraint) WithPayload(payload any) BICConstraint {
+	c.payload = payload
+	return c
+}
+
 func (c BICConstraint) bicOptions() []func(*validate.BICOptions) {
 	var opts []func(*validate.BICOptions)
 	if c.caseInsensitive {
@@ -118,6 +134,8 @@ func (c BICConstraint) ValidateString(ctx context.Context, validator *validation
 
 	if errors.Is(err, validate.ErrBICIBANCountryMismatch) {
 		return validator.BuildViolation(ctx, c.ibanErr, c.ibanMessageTemplate).
+			WithSeverity(c.severity).
+			WithPayload(c.payload).
 			WithParameters(
 				c.ibanMessageParameters.Prepend(
 					validation.TemplateParameter{Key: "{{ iban }}", Value: c.iban},
@@ -128,6 +146,8 @@ func (c BICConstraint) ValidateString(ctx context.Context, validator *validation
 	}
 
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithParameters(
 			c.messageParameters.Prepend(
 				validation.TemplateParameter{Key: "{{ value }}", Value: *value},
@@ -15,6 +15,7 @@ import (
 type ChoiceConstraint[T comparable] struct {
 	blank             T
 	choices           map[T]bool
+	values            []T
 	choicesValue      string
 	groups            []string
 	err               error
@@ -22,6 +23,9 @@ type ChoiceConstraint[T comparable] struct {
 	messageParameters validation.TemplateParameterList
 	disallowBlank     bool
 	isIgnored         bool
+	isSensitive       bool
+	severity          validation.Severity
+	payload           any
 }
 
 // IsOneOf creates a [ChoiceConstraint] for checking that values are in the expected list of values.
@@ -44,6 +48,7 @@ func IsOneOf[T comparable](values ...T) ChoiceConstraint[T] {
 
 	return ChoiceConstraint[T]{
 		choices:         choices,
+		values:          values,
 		choicesValue:    s.String(),
 		err:             validation.ErrNoSuchChoice,
 		messageTemplate: validation.ErrNoSuchChoice.Message(),
@@ -86,6 +91,41 @@ func (c ChoiceConstraint[T]) WhenGroups(groups ...string) ChoiceConstraint[T] {
 	return c
 }
 
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c ChoiceConstraint[T]) WithSeverity(severity validation.Severity) ChoiceConstraint[T] {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c ChoiceConstraint[T]) WithPayload(payload any) ChoiceConstraint[T] {
+	c.payload = payload
+	return c
+}
+
+// Sensitive marks the current value and the choices as secrets, so they are masked
+// in the violation message and parameters (see [validation.TemplateParameter.Sensitive]).
+func (c ChoiceConstraint[T]) Sensitive() ChoiceConstraint[T] {
+	c.isSensitive = true
+	return c
+}
+
+// Describe returns the describable form of the constraint (see [validation.DescribableConstraint]).
+func (c ChoiceConstraint[T]) Describe() validation.ConstraintDescription {
+	if c.isIgnored {
+		return validation.ConstraintDescription{}
+	}
+
+	enum := make([]any, len(c.values))
+	for i, value := range c.values {
+		enum[i] = value
+	}
+
+	return validation.ConstraintDescription{Enum: enum}
+}
+
 func (c ChoiceConstraint[T]) ValidateNumber(ctx context.Context, validator *validation.Validator, value *T) error {
 	return c.ValidateComparable(ctx, validator, value)
 }
@@ -107,10 +147,12 @@ func (c ChoiceConstraint[T]) ValidateComparable(ctx context.Context, validator *
 
 	return validator.
 		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithParameters(
 			c.messageParameters.Prepend(
-				validation.TemplateParameter{Key: "{{ value }}", Value: fmt.Sprint(*value)},
-				validation.TemplateParameter{Key: "{{ choices }}", Value: c.choicesValue},
+				validation.TemplateParameter{Key: "{{ value }}", Value: fmt.Sprint(*value), Sensitive: c.isSensitive},
+				validation.TemplateParameter{Key: "{{ choices }}", Value: c.choicesValue, Sensitive: c.isSensitive},
 			)...,
 		).
 		Create()
@@ -19,7 +19,11 @@ type ComparisonConstraint[T comparable] struct {
 	messageTemplate   string
 	messageParameters validation.TemplateParameterList
 	comparedValue     string
+	isEqual           bool
+	isSensitive       bool
 	isValid           func(value T) bool
+	severity          validation.Severity
+	payload           any
 }
 
 // IsEqualTo checks that the value is equal to the specified value.
@@ -29,6 +33,7 @@ func IsEqualTo[T comparable](value T) ComparisonConstraint[T] {
 		value:           value,
 		messageTemplate: validation.ErrNotEqual.Message(),
 		comparedValue:   formatComparable(value),
+		isEqual:         true,
 		isValid:         func(v T) bool { return v == value },
 	}
 }
@@ -77,6 +82,37 @@ func (c ComparisonConstraint[T]) WhenGroups(groups ...string) ComparisonConstrai
 	return c
 }
 
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c ComparisonConstraint[T]) WithSeverity(severity validation.Severity) ComparisonConstraint[T] {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c ComparisonConstraint[T]) WithPayload(payload any) ComparisonConstraint[T] {
+	c.payload = payload
+	return c
+}
+
+// Sensitive marks the compared and the current values as secrets, so they are masked
+// in the violation message and parameters (see [validation.TemplateParameter.Sensitive]).
+func (c ComparisonConstraint[T]) Sensitive() ComparisonConstraint[T] {
+	c.isSensitive = true
+	return c
+}
+
+// Describe returns the describable form of the constraint (see [validation.DescribableConstraint]).
+// Only the equality check can be described.
+func (c ComparisonConstraint[T]) Describe() validation.ConstraintDescription {
+	if c.isIgnored || !c.isEqual {
+		return validation.ConstraintDescription{}
+	}
+
+	return validation.ConstraintDescription{Enum: []any{c.value}}
+}
+
 func (c ComparisonConstraint[T]) ValidateNumber(ctx context.Context, validator *validation.Validator, value *T) error {
 	return c.ValidateComparable(ctx, validator, value)
 }
@@ -91,10 +127,12 @@ func (c ComparisonConstraint[T]) ValidateComparable(ctx context.Context, validat
 	}
 
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithParameters(
 			c.messageParameters.Prepend(
-				validation.TemplateParameter{Key: "{{ comparedValue }}", Value: c.comparedValue},
-				validation.TemplateParameter{Key: "{{ value }}", Value: formatComparable(*value)},
+				validation.TemplateParameter{Key: "{{ comparedValue }}", Value: c.comparedValue, Sensitive: c.isSensitive},
+				validation.TemplateParameter{Key: "{{ value }}", Value: formatComparable(*value), Sensitive: c.isSensitive},
 			)...,
 		).
 		Create()
@@ -105,6 +143,18 @@ func (c ComparisonConstraint[T]) Validate(ctx context.Context, validator *valida
 	return c.ValidateComparable(ctx, validator, &v)
 }
 
+// numberKeyword is used to describe the numeric comparison in terms of JSON Schema keywords.
+type numberKeyword byte
+
+const (
+	noKeyword numberKeyword = iota
+	minimumKeyword
+	maximumKeyword
+	exclusiveMinimumKeyword
+	exclusiveMaximumKeyword
+	multipleOfKeyword
+)
+
 // NumberComparisonConstraint is used for various numeric comparisons between integer and float values.
 type NumberComparisonConstraint[T validation.Numeric] struct {
 	isIgnored         bool
@@ -114,13 +164,17 @@ type NumberComparisonConstraint[T validation.Numeric] struct {
 	messageTemplate   string
 	messageParameters validation.TemplateParameterList
 	comparedValue     string
+	keyword           numberKeyword
 	isValid           func(value T) bool
+	severity          validation.Severity
+	payload           any
 }
 
 // IsLessThan checks that the number is less than the specified value.
 func IsLessThan[T validation.Numeric](value T) NumberComparisonConstraint[T] {
 	return NumberComparisonConstraint[T]{
 		err:             validation.ErrTooHigh,
+		keyword:         exclusiveMaximumKeyword,
 		value:           value,
 		messageTemplate: validation.ErrTooHigh.Message(),
 		comparedValue:   fmt.Sprint(value),
@@ -132,6 +186,7 @@ func IsLessThan[T validation.Numeric](value T) NumberComparisonConstraint[T] {
 func IsLessThanOrEqual[T validation.Numeric](value T) NumberComparisonConstraint[T] {
 	return NumberComparisonConstraint[T]{
 		err:             validation.ErrTooHighOrEqual,
+		keyword:         maximumKeyword,
 		value:           value,
 		messageTemplate: validation.ErrTooHighOrEqual.Message(),
 		comparedValue:   fmt.Sprint(value),
@@ -143,6 +198,7 @@ func IsLessThanOrEqual[T validation.Numeric](value T) NumberComparisonConstraint
 func IsGreaterThan[T validation.Numeric](value T) NumberComparisonConstraint[T] {
 	return NumberComparisonConstraint[T]{
 		err:             validation.ErrTooLow,
+		keyword:         exclusiveMinimumKeyword,
 		value:           value,
 		messageTemplate: validation.ErrTooLow.Message(),
 		comparedValue:   fmt.Sprint(value),
@@ -154,6 +210,7 @@ func IsGreaterThan[T validation.Numeric](value T) NumberComparisonConstraint[T]
 func IsGreaterThanOrEqual[T validation.Numeric](value T) NumberComparisonConstraint[T] {
 	return NumberComparisonConstraint[T]{
 		err:             validation.ErrTooLowOrEqual,
+		keyword:         minimumKeyword,
 		value:           value,
 		messageTemplate: validation.ErrTooLowOrEqual.Message(),
 		comparedValue:   fmt.Sprint(value),
@@ -166,6 +223,7 @@ func IsGreaterThanOrEqual[T validation.Numeric](value T) NumberComparisonConstra
 func IsPositive[T validation.Numeric]() NumberComparisonConstraint[T] {
 	return NumberComparisonConstraint[T]{
 		err:             validation.ErrNotPositive,
+		keyword:         exclusiveMinimumKeyword,
 		value:           0,
 		messageTemplate: validation.ErrNotPositive.Message(),
 		comparedValue:   "0",
@@ -178,6 +236,7 @@ func IsPositive[T validation.Numeric]() NumberComparisonConstraint[T] {
 func IsPositiveOrZero[T validation.Numeric]() NumberComparisonConstraint[T] {
 	return NumberComparisonConstraint[T]{
 		err:             validation.ErrNotPositiveOrZero,
+		keyword:         minimumKeyword,
 		value:           0,
 		messageTemplate: validation.ErrNotPositiveOrZero.Message(),
 		comparedValue:   "0",
@@ -190,6 +249,7 @@ func IsPositiveOrZero[T validation.Numeric]() NumberComparisonConstraint[T] {
 func IsNegative[T validation.Numeric]() NumberComparisonConstraint[T] {
 	return NumberComparisonConstraint[T]{
 		err:             validation.ErrNotNegative,
+		keyword:         exclusiveMaximumKeyword,
 		value:           0,
 		messageTemplate: validation.ErrNotNegative.Message(),
 		comparedValue:   "0",
@@ -202,6 +262,7 @@ func IsNegative[T validation.Numeric]() NumberComparisonConstraint[T] {
 func IsNegativeOrZero[T validation.Numeric]() NumberComparisonConstraint[T] {
 	return NumberComparisonConstraint[T]{
 		err:             validation.ErrNotNegativeOrZero,
+		keyword:         maximumKeyword,
 		value:           0,
 		messageTemplate: validation.ErrNotNegativeOrZero.Message(),
 		comparedValue:   "0",
@@ -218,6 +279,8 @@ func IsDivisibleBy[
 ) NumberComparisonConstraint[T] {
 	return NumberComparisonConstraint[T]{
 		err:             validation.ErrNotDivisible,
+		keyword:         multipleOfKeyword,
+		value:           divisor,
 		messageTemplate: validation.ErrNotDivisible.Message(),
 		comparedValue:   fmt.Sprint(divisor),
 		isValid:         func(n T) bool { return n%divisor == 0 },
@@ -229,6 +292,8 @@ func IsDivisibleBy[
 func IsDivisibleByFloat[T ~float32 | ~float64](divisor T) NumberComparisonConstraint[T] {
 	return NumberComparisonConstraint[T]{
 		err:             validation.ErrNotDivisible,
+		keyword:         multipleOfKeyword,
+		value:           divisor,
 		messageTemplate: validation.ErrNotDivisible.Message(),
 		comparedValue:   fmt.Sprint(divisor),
 		isValid:         func(n T) bool { return is.DivisibleBy(float64(n), float64(divisor)) },
@@ -268,12 +333,50 @@ func (c NumberComparisonConstraint[T]) WhenGroups(groups ...string) NumberCompar
 	return c
 }
 
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c NumberComparisonConstraint[T]) WithSeverity(severity validation.Severity) NumberComparisonConstraint[T] {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c NumberComparisonConstraint[T]) WithPayload(payload any) NumberComparisonConstraint[T] {
+	c.payload = payload
+	return c
+}
+
+// Describe returns the describable form of the constraint (see [validation.DescribableConstraint]).
+func (c NumberComparisonConstraint[T]) Describe() validation.ConstraintDescription {
+	if c.isIgnored {
+		return validation.ConstraintDescription{}
+	}
+
+	switch c.keyword {
+	case minimumKeyword:
+		return validation.ConstraintDescription{Minimum: c.value}
+	case maximumKeyword:
+		return validation.ConstraintDescription{Maximum: c.value}
+	case exclusiveMinimumKeyword:
+		return validation.ConstraintDescription{ExclusiveMinimum: c.value}
+	case exclusiveMaximumKeyword:
+		return validation.ConstraintDescription{ExclusiveMaximum: c.value}
+	case multipleOfKeyword:
+		return validation.ConstraintDescription{MultipleOf: c.value}
+	default:
+		return validation.ConstraintDescription{}
+	}
+}
+
 func (c NumberComparisonConstraint[T]) ValidateNumber(ctx context.Context, validator *validation.Validator, value *T) error {
 	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || c.isValid(*value) {
 		return nil
 	}
 
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithParameters(
 			c.messageParameters.Prepend(
 				validation.TemplateParameter{Key: "{{ comparedValue }}", Value: c.comparedValue},
@@ -297,6 +400,8 @@ type RangeConstraint[T validation.Numeric] struct {
 	messageParameters validation.TemplateParameterList
 	min               T
 	max               T
+	severity          validation.Severity
+	payload           any
 }
 
 // IsBetween checks that the number is between specified minimum and maximum numeric values.
@@ -345,6 +450,29 @@ func (c RangeConstraint[T]) WhenGroups(groups ...string) RangeConstraint[T] {
 	return c
 }
 
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c RangeConstraint[T]) WithSeverity(severity validation.Severity) RangeConstraint[T] {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c RangeConstraint[T]) WithPayload(payload any) RangeConstraint[T] {
+	c.payload = payload
+	return c
+}
+
+// Describe returns the describable form of the constraint (see [validation.DescribableConstraint]).
+func (c RangeConstraint[T]) Describe() validation.ConstraintDescription {
+	if c.isIgnored {
+		return validation.ConstraintDescription{}
+	}
+
+	return validation.ConstraintDescription{Minimum: c.min, Maximum: c.max}
+}
+
 func (c RangeConstraint[T]) ValidateNumber(ctx context.Context, validator *validation.Validator, value *T) error {
 	if c.min >= c.max {
 		return validator.CreateConstraintError(c.Name(), "invalid range")
@@ -357,6 +485,8 @@ func (c RangeConstraint[T]) ValidateNumber(ctx context.Context, validator *valid
 	}
 
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithParameters(
 			c.messageParameters.Prepend(
 				validation.TemplateParameter{Key: "{{ min }}", Value: fmt.Sprint(c.min)},
@@ -382,6 +512,8 @@ type TimeComparisonConstraint struct {
 	comparedValue     time.Time
 	layout            string
 	isValid           func(value time.Time) bool
+	severity          validation.Severity
+	payload           any
 }
 
 // IsEarlierThan checks that the given time is earlier than the specified value.
@@ -478,12 +610,28 @@ func (c TimeComparisonConstraint) WhenGroups(groups ...string) TimeComparisonCon
 	return c
 }
 
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c TimeComparisonConstraint) WithSeverity(severity validation.Severity) TimeComparisonConstraint {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c TimeComparisonConstraint) WithPayload(payload any) TimeComparisonConstraint {
+	c.payload = payload
+	return c
+}
+
 func (c TimeComparisonConstraint) ValidateTime(ctx context.Context, validator *validation.Validator, value *time.Time) error {
 	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || c.isValid(*value) {
 		return nil
 	}
 
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithParameters(
 			c.messageParameters.Prepend(
 				validation.TemplateParameter{Key: "{{ comparedValue }}", Value: c.comparedValue.Format(c.layout)},
@@ -508,6 +656,8 @@ type TimeRangeConstraint struct {
 	layout            string
 	min               time.Time
 	max               time.Time
+	severity          validation.Severity
+	payload           any
 }
 
 // IsBetweenTime checks that the time is between specified minimum and maximum time values.
@@ -555,6 +705,20 @@ func (c TimeRangeConstraint) WhenGroups(groups ...string) TimeRangeConstraint {
 	return c
 }
 
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c TimeRangeConstraint) WithSeverity(severity validation.Severity) TimeRangeConstraint {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c TimeRangeConstraint) WithPayload(payload any) TimeRangeConstraint {
+	c.payload = payload
+	return c
+}
+
 // WithLayout can be used to set the layout that is used to format time values.
 func (c TimeRangeConstraint) WithLayout(layout string) TimeRangeConstraint {
 	c.layout = layout
@@ -577,6 +741,8 @@ func (c TimeRangeConstraint) ValidateTime(ctx context.Context, validator *valida
 
 func (c TimeRangeConstraint) newViolation(ctx context.Context, validator *validation.Validator, value *time.Time) validation.Violation {
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithParameters(
 			c.messageParameters.Prepend(
 				validation.TemplateParameter{Key: "{{ min }}", Value: c.min.Format(c.layout)},
@@ -599,6 +765,8 @@ type UniqueConstraint[T comparable] struct {
 	err               error
 	messageTemplate   string
 	messageParameters validation.TemplateParameterList
+	severity          validation.Severity
+	payload           any
 }
 
 // HasUniqueValues checks that all elements of the given collection are unique
@@ -637,12 +805,33 @@ func (c UniqueConstraint[T]) WhenGroups(groups ...string) UniqueConstraint[T] {
 	return c
 }
 
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c UniqueConstraint[T]) WithSeverity(severity validation.Severity) UniqueConstraint[T] {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c UniqueConstraint[T]) WithPayload(payload any) UniqueConstraint[T] {
+	c.payload = payload
+	return c
+}
+
+// Describe returns the describable form of the constraint (see [validation.DescribableConstraint]).
+func (c UniqueConstraint[T]) Describe() validation.ConstraintDescription {
+	return validation.ConstraintDescription{UniqueItems: !c.isIgnored}
+}
+
 func (c UniqueConstraint[T]) ValidateComparables(ctx context.Context, validator *validation.Validator, values []T) error {
 	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || is.Unique(values) {
 		return nil
 	}
 
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithParameters(c.messageParameters...).
 		Create()
 }
@@ -665,6 +854,8 @@ type UniqueByConstraint[T any, K comparable] struct {
 	skip              SkipUniqueItemFunc[T]
 	skipEmptyKeys     bool
 	propertyPath      []validation.PropertyPathElement
+	severity          validation.Severity
+	payload           any
 }
 
 // HasUniqueValuesBy checks that all elements of the given slice are unique by the key
@@ -704,6 +895,20 @@ func (c UniqueByConstraint[T, K]) WhenGroups(groups ...string) UniqueByConstrain
 	return c
 }
 
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c UniqueByConstraint[T, K]) WithSeverity(severity validation.Severity) UniqueByConstraint[T, K] {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c UniqueByConstraint[T, K]) WithPayload(payload any) UniqueByConstraint[T, K] {
+	c.payload = payload
+	return c
+}
+
 // SkipWhen sets a function to skip elements that should not participate in the uniqueness check.
 func (c UniqueByConstraint[T, K]) SkipWhen(skip SkipUniqueItemFunc[T]) UniqueByConstraint[T, K] {
 	c.skip = skip
@@ -744,6 +949,8 @@ func (c UniqueByConstraint[T, K]) ValidateSlice(ctx context.Context, validator *
 
 		if itemsCountByKey[key] > 1 {
 			builder.BuildViolation(c.err, c.messageTemplate).
+				WithSeverity(c.severity).
+				WithPayload(c.payload).
 				AtIndex(i).
 				At(c.propertyPath...).
 				WithParameters(c.messageParameters...).
@@ -751,7 +958,7 @@ func (c UniqueByConstraint[T, K]) ValidateSlice(ctx context.Context, validator *
 		}
 	}
 
-	return builder.Create().AsError()
+	return violationsError(builder.Create())
 }
 
 func (c UniqueByConstraint[T, K]) collectItemsCountByKey(items []T) map[K]int {
@@ -779,3 +986,14 @@ func formatComparable[T comparable](value T) string {
 
 	return fmt.Sprint(value)
 }
+
+// violationsError returns the non-empty list as an error including the non-blocking violations,
+// so the validator receives the warnings of the slice constraints. [validation.ViolationList.AsError]
+// is not used, because it drops the list without blocking violations.
+func violationsError(violations *validation.ViolationList) error {
+	if violations.Len() == 0 {
+		return nil
+	}
+
+	return violations
+}
@@ -16,6 +16,8 @@ type DateTimeConstraint struct {
 	layout            string
 	messageTemplate   string
 	messageParameters validation.TemplateParameterList
+	severity          validation.Severity
+	payload           any
 }
 
 // IsDateTime checks that the string value is a valid date and time. By default, it uses [time.RFC3339] layout.
@@ -84,6 +86,39 @@ func (c DateTimeConstraint) WhenGroups(groups ...string) DateTimeConstraint {
 	return c
 }
 
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c DateTimeConstraint) WithSeverity(severity validation.Severity) DateTimeConstraint {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c DateTimeConstraint) WithPayload(payload any) DateTimeConstraint {
+	c.payload = payload
+	return c
+}
+
+// Describe returns the describable form of the constraint (see [validation.DescribableConstraint]).
+// Only the default layouts of [IsDateTime], [IsDate], and [IsTime] constraints can be described.
+func (c DateTimeConstraint) Describe() validation.ConstraintDescription {
+	if c.isIgnored {
+		return validation.ConstraintDescription{}
+	}
+
+	switch c.layout {
+	case time.RFC3339:
+		return validation.ConstraintDescription{Format: "date-time"}
+	case "2006-01-02":
+		return validation.ConstraintDescription{Format: "date"}
+	case "15:04:05":
+		return validation.ConstraintDescription{Format: "time"}
+	default:
+		return validation.ConstraintDescription{}
+	}
+}
+
 func (c DateTimeConstraint) ValidateString(ctx context.Context, validator *validation.Validator, value *string) error {
 	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
 		return nil
@@ -93,6 +128,8 @@ func (c DateTimeConstraint) ValidateString(ctx context.Context, validator *valid
 	}
 
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithParameters(
 			c.messageParameters.Prepend(
 				validation.TemplateParameter{Key: "{{ layout }}", Value: c.layout},
@@ -3,7 +3,7 @@ package it_test
 import (
 	"context"
 	"fmt"
-	"net"
+	"net/netip"
 	"net/url"
 	"regexp"
 	"time"
@@ -813,6 +813,23 @@ func ExampleIsHTML5Email_invalidEmail() {
 	// violation: "This value is not a valid email address."
 }
 
+func ExampleIsStrictEmail() {
+	err := validator.Validate(
+		context.Background(),
+		validation.StringProperty("quoted", `"john doe"@example.com`, it.IsStrictEmail()),
+		validation.StringProperty("local", "john..doe@example.com", it.IsStrictEmail()),
+		validation.StringProperty("domain", "john.doe@example", it.IsStrictEmail()),
+	)
+	if violations, ok := validation.UnwrapViolationList(err); ok {
+		for _, violation := range violations.All() {
+			fmt.Println(violation.PropertyPath(), violation.Unwrap(), violation.Message())
+		}
+	}
+	// Output:
+	// local invalid email local part The local part of this email address is not valid.
+	// domain invalid email domain The domain of this email address is not valid.
+}
+
 func ExampleIsHostname_validHostname() {
 	v := "example.com"
 	err := validator.Validate(context.Background(), validation.String(v, it.IsHostname()))
@@ -837,6 +854,24 @@ func ExampleIsHostname_reservedHostname() {
 	// violation: "This value is not a valid hostname."
 }
 
+func ExampleIsIDNHostname() {
+	violations := validator.Validate(
+		context.Background(),
+		validation.String("пример.рф", it.IsIDNHostname()),
+		validation.String("pаypal.com", it.IsIDNHostname().DenyMixedScripts()), // Cyrillic "а"
+	)
+	fmt.Println(violations)
+	// Output:
+	// violation: "This value contains characters that are not allowed by the current restriction level."
+}
+
+func ExampleIsIDNEmail() {
+	err := validator.Validate(context.Background(), validation.String("пользователь@пример.рф", it.IsIDNEmail()))
+	fmt.Println(err)
+	// Output:
+	// <nil>
+}
+
 func ExampleIsLooseHostname_validHostname() {
 	v := "example.com"
 	err := validator.Validate(context.Background(), validation.String(v, it.IsLooseHostname()))
@@ -1059,7 +1094,7 @@ func ExampleIPConstraint_DenyIP() {
 		context.Background(),
 		validation.String(
 			v,
-			it.IsIP().DenyIP(func(ip net.IP) bool {
+			it.IsIP().DenyIP(func(ip netip.Addr) bool {
 				return ip.IsLoopback()
 			}),
 		),
@@ -1069,6 +1104,59 @@ func ExampleIPConstraint_DenyIP() {
 	// violation: "This IP address is prohibited to use."
 }
 
+func ExampleIPConstraint_AllowOnlyPrefixes() {
+	v := "192.168.1.10"
+	err := validator.Validate(
+		context.Background(),
+		validation.String(v, it.IsIP().AllowOnlyPrefixes(netip.MustParsePrefix("10.0.0.0/8"))),
+	)
+	fmt.Println(err)
+	// Output:
+	// violation: "This IP address is prohibited to use."
+}
+
+func ExampleIPConstraint_ValidateComparable() {
+	ip := netip.MustParseAddr("::ffff:169.254.169.254")
+	err := validator.Validate(
+		context.Background(),
+		validation.Comparable(ip, it.IsIP().DenyLoopback().DenyLinkLocal()),
+	)
+	fmt.Println(err)
+	// Output:
+	// violation: "This IP address is prohibited to use."
+}
+
+func ExampleCIDRConstraint_WithinNetworks() {
+	err := validator.Validate(
+		context.Background(),
+		validation.String(
+			"10.0.1.0/24",
+			it.IsCIDR().
+				WithinNetworks(netip.MustParsePrefix("10.0.0.0/16")).
+				DenyOverlapping(netip.MustParsePrefix("10.0.0.0/24"), netip.MustParsePrefix("10.0.1.128/25")),
+		),
+	)
+	fmt.Println(err)
+	// Output:
+	// violation: "This network overlaps with the network 10.0.1.128/25."
+}
+
+func ExampleHasNoOverlappingCIDRs() {
+	subnets := []string{"10.0.0.0/24", "10.0.1.0/24", "10.0.0.0/16"}
+	err := validator.Validate(
+		context.Background(),
+		validation.SliceProperty("subnets", subnets, it.HasNoOverlappingCIDRs()),
+	)
+	violations, _ := validation.UnwrapViolationList(err)
+	for _, violation := range violations.All() {
+		fmt.Println(violation.PropertyPath(), violation.Message())
+	}
+	// Output:
+	// subnets[0] This network overlaps with the network 10.0.0.0/16.
+	// subnets[1] This network overlaps with the network 10.0.0.0/16.
+	// subnets[2] This network overlaps with the network 10.0.0.0/24.
+}
+
 func ExampleHasNoSuspiciousCharacters_valid() {
 	err := validator.Validate(context.Background(), validation.String("alice", it.HasNoSuspiciousCharacters()))
 	fmt.Println(err)
@@ -1082,3 +1170,30 @@ func ExampleHasNoSuspiciousCharacters_invalid() {
 	// Output:
 	// violation: "Using invisible characters is not allowed."
 }
+
+func ExampleMatchesJSONSchema() {
+	schema := `{
+		"type": "object",
+		"properties": {
+			"email": {"type": "string", "format": "email"},
+			"tags": {"type": "array", "items": {"type": "string", "maxLength": 5}}
+		},
+		"required": ["email", "name"]
+	}`
+	document := `{"email": "invalid", "tags": ["go", "validation"]}`
+
+	err := validator.Validate(
+		context.Background(),
+		validation.StringProperty("body", document, it.MatchesJSONSchema(schema)),
+	)
+
+	if violations, ok := validation.UnwrapViolations(err); ok {
+		for _, violation := range violations.All() {
+			fmt.Println(violation.PropertyPath(), "-", violation.Message())
+		}
+	}
+	// Output:
+	// body.email - This value is not a valid email address.
+	// body.name - This field is missing.
+	// body.tags[1] - This value is too long. It should have 5 characters or less.
+}
@@ -85,6 +85,8 @@ type UUIDConstraint struct {
 	err               error
 	messageTemplate   string
 	messageParameters validation.TemplateParameterList
+	severity          validation.Severity
+	payload           any
 }
 
 // IsUUID validates whether a string value is a valid UUID (also known as GUID).
@@ -153,6 +155,29 @@ func (c UUIDConstraint) WhenGroups(groups ...string) UUIDConstraint {
 	return c
 }
 
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c UUIDConstraint) WithSeverity(severity validation.Severity) UUIDConstraint {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c UUIDConstraint) WithPayload(payload any) UUIDConstraint {
+	c.payload = payload
+	return c
+}
+
+// Describe returns the describable form of the constraint (see [validation.DescribableConstraint]).
+func (c UUIDConstraint) Describe() validation.ConstraintDescription {
+	if c.isIgnored {
+		return validation.ConstraintDescription{}
+	}
+
+	return validation.ConstraintDescription{Format: "uuid"}
+}
+
 func (c UUIDConstraint) ValidateString(ctx context.Context, validator *validation.Validator, value *string) error {
 	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
 		return nil
@@ -162,6 +187,8 @@ func (c UUIDConstraint) ValidateString(ctx context.Context, validator *validatio
 	}
 
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithParameters(
 			c.messageParameters.Prepend(
 				validation.TemplateParameter{Key: "{{ value }}", Value: *value},
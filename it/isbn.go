@@ -38,6 +38,8 @@ type ISBNConstraint struct {
 	message13     string
 	messageBoth   string
 	messageParams validation.TemplateParameterList
+	severity      validation.Severity
+	payload       any
 }
 
 // IsISBN validates whether the value is a valid ISBN-10 or ISBN-13.
@@ -134,6 +136,20 @@ func (c ISBNConstraint) WhenGroups(groups ...string) ISBNConstraint {
 	return c
 }
 
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c ISBNConstraint) WithSeverity(severity validation.Severity) ISBNConstraint {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c ISBNConstraint) WithPayload(payload any) ISBNConstraint {
+	c.payload = payload
+	return c
+}
+
 func (c ISBNConstraint) ValidateString(ctx context.Context, validator *validation.Validator, value *string) error {
 	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
 		return nil
@@ -160,6 +176,8 @@ func (c ISBNConstraint) ValidateString(ctx context.Context, validator *validatio
 	}
 
 	return validator.BuildViolation(ctx, vErr, msg).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithParameters(
 			c.messageParams.Prepend(
 				validation.TemplateParameter{Key: "{{ value }}", Value: *value},
@@ -30,6 +30,8 @@ type CountConstraint struct {
 	exactMessageParameters       validation.TemplateParameterList
 	divisibleByMessageTemplate   string
 	divisibleByMessageParameters validation.TemplateParameterList
+	severity                     validation.Severity
+	payload                      any
 }
 
 func newCountConstraint() CountConstraint {
@@ -102,6 +104,20 @@ func (c CountConstraint) WhenGroups(groups ...string) CountConstraint {
 	return c
 }
 
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c CountConstraint) WithSeverity(severity validation.Severity) CountConstraint {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c CountConstraint) WithPayload(payload any) CountConstraint {
+	c.payload = payload
+	return c
+}
+
 // WithMinError overrides default underlying error for violation that will be shown if the
 // collection length is less than the minimum value.
 func (c CountConstraint) WithMinError(err error) CountConstraint {
@@ -178,6 +194,22 @@ func (c CountConstraint) WithDivisibleMessage(template string, parameters ...val
 	return c
 }
 
+// Describe returns the describable form of the constraint (see [validation.DescribableConstraint]).
+func (c CountConstraint) Describe() validation.ConstraintDescription {
+	var description validation.ConstraintDescription
+	if c.isIgnored {
+		return description
+	}
+	if c.checkMin {
+		description.MinItems = &c.min
+	}
+	if c.checkMax {
+		description.MaxItems = &c.max
+	}
+
+	return description
+}
+
 func (c CountConstraint) ValidateCountable(ctx context.Context, validator *validation.Validator, count int) error {
 	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) {
 		return nil
@@ -210,6 +242,8 @@ func (c CountConstraint) newViolation(
 	}
 
 	return validator.BuildViolation(ctx, err, template).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithPluralCount(limit).
 		WithParameters(
 			parameters.Prepend(
@@ -226,6 +260,8 @@ func (c CountConstraint) newNotDivisibleViolation(
 	count int,
 ) validation.Violation {
 	return validator.BuildViolation(ctx, c.divisibleErr, c.divisibleByMessageTemplate).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithPluralCount(c.divisibleBy).
 		WithParameters(
 			c.divisibleByMessageParameters.Prepend(
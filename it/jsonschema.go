@@ -0,0 +1,408 @@
+package it
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/muonsoft/validation"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/santhosh-tekuri/jsonschema/v6/kind"
+)
+
+const jsonSchemaResource = "schema.json"
+
+// JSONSchemaConstraint is used to validate that a JSON document matches the JSON Schema.
+// Each schema failure is converted into a separate violation with the property path
+// built from the JSON pointer of the failing instance (for example, "/items/0/price"
+// is converted into "items[0].price"). Violations are sorted by the instance location.
+//
+// Schema keywords are converted into the built-in errors, so violations can be checked
+// by [errors.Is] as any other violation:
+//   - "type" - [validation.ErrInvalidType];
+//   - "required" and "dependentRequired" - [validation.ErrMissingProperty]
+//     at the path of the missing property;
+//   - "additionalProperties" - [validation.ErrUnexpectedProperty] at the path of the unexpected property;
+//   - "enum" and "const" - [validation.ErrNoSuchChoice];
+//   - "minLength" and "maxLength" - [validation.ErrTooShort] and [validation.ErrTooLong];
+//   - "minimum", "exclusiveMinimum", "maximum", and "exclusiveMaximum" - [validation.ErrTooLowOrEqual],
+//     [validation.ErrTooLow], [validation.ErrTooHighOrEqual], and [validation.ErrTooHigh];
+//   - "multipleOf" - [validation.ErrNotDivisible];
+//   - "minItems", "maxItems", "minProperties", and "maxProperties" - [validation.ErrTooFewElements]
+//     and [validation.ErrTooManyElements];
+//   - "uniqueItems" - [validation.ErrNotUnique];
+//   - "format" - [validation.ErrInvalidEmail], [validation.ErrInvalidURL], [validation.ErrInvalidUUID],
+//     [validation.ErrInvalidDateTime], [validation.ErrInvalidDate], [validation.ErrInvalidTime],
+//     [validation.ErrInvalidIP], [validation.ErrInvalidHostname] for the known formats
+//     and [validation.ErrInvalidFormat] for others;
+//   - all other keywords (for example, "pattern", "anyOf", "oneOf", "not") - [validation.ErrNotValid].
+type JSONSchemaConstraint struct {
+	isIgnored  bool
+	groups     []string
+	schema     *jsonschema.Schema
+	compileErr error
+	severity   validation.Severity
+	payload    any
+}
+
+// MatchesJSONSchema creates a [JSONSchemaConstraint] to validate that a JSON document matches
+// the JSON Schema. The schema is compiled once on creation, draft 2020-12 is used
+// if the dialect is not set by the "$schema" keyword. The "format" keyword is asserted.
+//
+// If the schema is not valid, then the validation process will be terminated
+// with [validation.ConstraintError].
+func MatchesJSONSchema(schema string) JSONSchemaConstraint {
+	compiled, err := compileJSONSchema(schema)
+
+	return JSONSchemaConstraint{schema: compiled, compileErr: err}
+}
+
+// When enables conditional validation of this constraint. If the expression evaluates to false,
+// then the constraint will be ignored.
+func (c JSONSchemaConstraint) When(condition bool) JSONSchemaConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+// WhenGroups enables conditional validation of the constraint by using the validation groups.
+func (c JSONSchemaConstraint) WhenGroups(groups ...string) JSONSchemaConstraint {
+	c.groups = groups
+	return c
+}
+
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c JSONSchemaConstraint) WithSeverity(severity validation.Severity) JSONSchemaConstraint {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c JSONSchemaConstraint) WithPayload(payload any) JSONSchemaConstraint {
+	c.payload = payload
+	return c
+}
+
+// ValidateString validates the JSON document passed as a string. Nil and empty values are valid.
+// If the value is not a valid JSON, then the violation with [validation.ErrInvalidJSON] is returned.
+func (c JSONSchemaConstraint) ValidateString(ctx context.Context, validator *validation.Validator, value *string) error {
+	if c.compileErr != nil {
+		return validator.CreateConstraintError("JSONSchemaConstraint", c.compileErr.Error())
+	}
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	document, err := jsonschema.UnmarshalJSON(strings.NewReader(*value))
+	if err != nil {
+		return validator.BuildViolation(ctx, validation.ErrInvalidJSON, validation.ErrInvalidJSON.Message()).
+			WithSeverity(c.severity).
+			WithPayload(c.payload).
+			WithParameter("{{ value }}", *value).
+			Create()
+	}
+
+	return c.validate(ctx, validator, document)
+}
+
+// Validate validates the decoded JSON document (for example, map[string]any or []any).
+// Any value that can be encoded by the [encoding/json] package is accepted, so it can also be a struct.
+// Implements [validation.Constraint][any].
+func (c JSONSchemaConstraint) Validate(ctx context.Context, validator *validation.Validator, v any) error {
+	if c.compileErr != nil {
+		return validator.CreateConstraintError("JSONSchemaConstraint", c.compileErr.Error())
+	}
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) {
+		return nil
+	}
+
+	// the value is normalized to the JSON data model supported by the schema validator
+	data, err := json.Marshal(v)
+	if err != nil {
+		return validator.CreateConstraintError("JSONSchemaConstraint", fmt.Sprintf("encode value: %s", err))
+	}
+	document, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+	if err != nil {
+		return validator.CreateConstraintError("JSONSchemaConstraint", fmt.Sprintf("decode value: %s", err))
+	}
+
+	return c.validate(ctx, validator, document)
+}
+
+func (c JSONSchemaConstraint) validate(ctx context.Context, validator *validation.Validator, document any) error {
+	err := c.schema.Validate(document)
+	if err == nil {
+		return nil
+	}
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return validator.CreateConstraintError("JSONSchemaConstraint", err.Error())
+	}
+
+	failures := collectJSONSchemaFailures(nil, validationErr)
+	slices.SortStableFunc(failures, func(a, b jsonSchemaFailure) int {
+		return compareJSONPointers(a.location, b.location)
+	})
+
+	violations := validator.BuildViolationList(ctx)
+	for _, failure := range failures {
+		failure.add(violations, jsonPointerToPath(document, failure.location), c)
+	}
+
+	return violationsError(violations.Create())
+}
+
+func compileJSONSchema(schema string) (*jsonschema.Schema, error) {
+	document, err := jsonschema.UnmarshalJSON(strings.NewReader(schema))
+	if err != nil {
+		return nil, fmt.Errorf("parse schema: %w", err)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	compiler.DefaultDraft(jsonschema.Draft2020)
+	compiler.AssertFormat()
+	if err := compiler.AddResource(jsonSchemaResource, document); err != nil {
+		return nil, fmt.Errorf("add schema: %w", err)
+	}
+	compiled, err := compiler.Compile(jsonSchemaResource)
+	if err != nil {
+		return nil, fmt.Errorf("compile schema: %w", err)
+	}
+
+	return compiled, nil
+}
+
+// jsonSchemaFailure is a single schema failure converted into the violation.
+type jsonSchemaFailure struct {
+	location    []string
+	err         *validation.Error
+	parameters  []validation.TemplateParameter
+	pluralCount int
+}
+
+func (failure jsonSchemaFailure) add(
+	violations *validation.ViolationListBuilder,
+	path []validation.PropertyPathElement,
+	c JSONSchemaConstraint,
+) {
+	violations.BuildViolation(failure.err, failure.err.Message()).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
+		WithParameters(failure.parameters...).
+		WithPluralCount(failure.pluralCount).
+		At(path...).
+		Add()
+}
+
+func collectJSONSchemaFailures(failures []jsonSchemaFailure, err *jsonschema.ValidationError) []jsonSchemaFailure {
+	switch k := err.ErrorKind.(type) {
+	case *kind.AnyOf, *kind.OneOf, *kind.Not, *kind.Contains, *kind.FalseSchema:
+		// the causes of the alternatives are ambiguous, so the failure is reported as a whole
+		return append(failures, newJSONSchemaFailure(err.InstanceLocation, validation.ErrNotValid))
+	case *kind.PropertyNames:
+		return append(failures, newJSONSchemaFailure(
+			appendLocation(err.InstanceLocation, k.Property), validation.ErrNotValid,
+		))
+	case *kind.Required:
+		for _, name := range k.Missing {
+			failures = append(failures, newJSONSchemaFailure(
+				appendLocation(err.InstanceLocation, name), validation.ErrMissingProperty,
+			))
+		}
+		return failures
+	case *kind.DependentRequired:
+		for _, name := range k.Missing {
+			failures = append(failures, newJSONSchemaFailure(
+				appendLocation(err.InstanceLocation, name), validation.ErrMissingProperty,
+			))
+		}
+		return failures
+	case *kind.AdditionalProperties:
+		properties := slices.Sorted(slices.Values(k.Properties))
+		for _, name := range properties {
+			failures = append(failures, newJSONSchemaFailure(
+				appendLocation(err.InstanceLocation, name), validation.ErrUnexpectedProperty,
+			))
+		}
+		return failures
+	}
+
+	if len(err.Causes) > 0 {
+		for _, cause := range err.Causes {
+			failures = collectJSONSchemaFailures(failures, cause)
+		}
+		return failures
+	}
+
+	return append(failures, newJSONSchemaKeywordFailure(err.InstanceLocation, err.ErrorKind))
+}
+
+func newJSONSchemaFailure(location []string, err *validation.Error) jsonSchemaFailure {
+	return jsonSchemaFailure{location: location, err: err}
+}
+
+//nolint:cyclop,funlen
+func newJSONSchemaKeywordFailure(location []string, errorKind jsonschema.ErrorKind) jsonSchemaFailure {
+	failure := jsonSchemaFailure{location: location, err: validation.ErrNotValid}
+
+	switch k := errorKind.(type) {
+	case *kind.InvalidJsonValue:
+		failure.err = validation.ErrInvalidJSON
+	case *kind.Type:
+		failure.err = validation.ErrInvalidType
+		failure.parameters = []validation.TemplateParameter{
+			{Key: "{{ type }}", Value: strings.Join(k.Want, " or ")},
+		}
+	case *kind.Enum, *kind.Const:
+		failure.err = validation.ErrNoSuchChoice
+	case *kind.Format:
+		failure.err = jsonSchemaFormatError(k.Want)
+		failure.parameters = []validation.TemplateParameter{{Key: "{{ format }}", Value: k.Want}}
+	case *kind.MinLength:
+		failure.err = validation.ErrTooShort
+		failure.parameters, failure.pluralCount = jsonSchemaLimitParameters("{{ length }}", k.Got, k.Want)
+	case *kind.MaxLength:
+		failure.err = validation.ErrTooLong
+		failure.parameters, failure.pluralCount = jsonSchemaLimitParameters("{{ length }}", k.Got, k.Want)
+	case *kind.MinItems:
+		failure.err = validation.ErrTooFewElements
+		failure.parameters, failure.pluralCount = jsonSchemaLimitParameters("{{ count }}", k.Got, k.Want)
+	case *kind.MaxItems:
+		failure.err = validation.ErrTooManyElements
+		failure.parameters, failure.pluralCount = jsonSchemaLimitParameters("{{ count }}", k.Got, k.Want)
+	case *kind.MinProperties:
+		failure.err = validation.ErrTooFewElements
+		failure.parameters, failure.pluralCount = jsonSchemaLimitParameters("{{ count }}", k.Got, k.Want)
+	case *kind.MaxProperties:
+		failure.err = validation.ErrTooManyElements
+		failure.parameters, failure.pluralCount = jsonSchemaLimitParameters("{{ count }}", k.Got, k.Want)
+	case *kind.Minimum:
+		failure.err = validation.ErrTooLowOrEqual
+		failure.parameters = jsonSchemaComparisonParameters(k.Got, k.Want)
+	case *kind.ExclusiveMinimum:
+		failure.err = validation.ErrTooLow
+		failure.parameters = jsonSchemaComparisonParameters(k.Got, k.Want)
+	case *kind.Maximum:
+		failure.err = validation.ErrTooHighOrEqual
+		failure.parameters = jsonSchemaComparisonParameters(k.Got, k.Want)
+	case *kind.ExclusiveMaximum:
+		failure.err = validation.ErrTooHigh
+		failure.parameters = jsonSchemaComparisonParameters(k.Got, k.Want)
+	case *kind.MultipleOf:
+		failure.err = validation.ErrNotDivisible
+		failure.parameters = jsonSchemaComparisonParameters(k.Got, k.Want)
+	case *kind.UniqueItems:
+		failure.err = validation.ErrNotUnique
+	}
+
+	return failure
+}
+
+func jsonSchemaFormatError(format string) *validation.Error {
+	switch format {
+	case "email", "idn-email":
+		return validation.ErrInvalidEmail
+	case "uri", "uri-reference", "iri", "iri-reference":
+		return validation.ErrInvalidURL
+	case "uuid":
+		return validation.ErrInvalidUUID
+	case "date-time":
+		return validation.ErrInvalidDateTime
+	case "date":
+		return validation.ErrInvalidDate
+	case "time":
+		return validation.ErrInvalidTime
+	case "ipv4", "ipv6":
+		return validation.ErrInvalidIP
+	case "hostname", "idn-hostname":
+		return validation.ErrInvalidHostname
+	default:
+		return validation.ErrInvalidFormat
+	}
+}
+
+func jsonSchemaLimitParameters(key string, got, want int) ([]validation.TemplateParameter, int) {
+	return []validation.TemplateParameter{
+		{Key: key, Value: strconv.Itoa(got)},
+		{Key: "{{ limit }}", Value: strconv.Itoa(want)},
+	}, want
+}
+
+func jsonSchemaComparisonParameters(got, want *big.Rat) []validation.TemplateParameter {
+	return []validation.TemplateParameter{
+		{Key: "{{ value }}", Value: formatRat(got)},
+		{Key: "{{ comparedValue }}", Value: formatRat(want)},
+	}
+}
+
+func formatRat(r *big.Rat) string {
+	if r == nil {
+		return ""
+	}
+	if r.IsInt() {
+		return r.Num().String()
+	}
+	f, _ := r.Float64()
+
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func appendLocation(location []string, token string) []string {
+	return append(slices.Clip(location), token)
+}
+
+// jsonPointerToPath converts tokens of the JSON pointer into the property path. Tokens
+// pointing to the array elements are converted into the array indexes.
+func jsonPointerToPath(document any, location []string) []validation.PropertyPathElement {
+	path := make([]validation.PropertyPathElement, 0, len(location))
+	node := document
+	for _, token := range location {
+		switch v := node.(type) {
+		case []any:
+			index, err := strconv.Atoi(token)
+			if err == nil {
+				path = append(path, validation.ArrayIndex(index))
+				if index >= 0 && index < len(v) {
+					node = v[index]
+				} else {
+					node = nil
+				}
+				continue
+			}
+			node = nil
+		case map[string]any:
+			node = v[token]
+		default:
+			node = nil
+		}
+		path = append(path, validation.PropertyName(token))
+	}
+
+	return path
+}
+
+func compareJSONPointers(a, b []string) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		x, errX := strconv.Atoi(a[i])
+		y, errY := strconv.Atoi(b[i])
+		if errX == nil && errY == nil {
+			if c := cmp.Compare(x, y); c != 0 {
+				return c
+			}
+			continue
+		}
+		if c := strings.Compare(a[i], b[i]); c != 0 {
+			return c
+		}
+	}
+
+	return cmp.Compare(len(a), len(b))
+}
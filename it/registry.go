@@ -0,0 +1,400 @@
+package it
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/muonsoft/validation"
+)
+
+var (
+	errUnsupportedValueType = errors.New("unsupported value type")
+	errUnexpectedOptions    = errors.New("unexpected options")
+	errInvalidOptions       = errors.New("invalid options")
+)
+
+func init() {
+	RegisterConstraints(validation.DefaultConstraintRegistry())
+}
+
+// RegisterConstraints registers the built-in constraints in the given registry, so they can be
+// resolved by names (for example, "notblank", "length", "email"). The built-in constraints are
+// registered in [validation.DefaultConstraintRegistry] on the package initialization.
+func RegisterConstraints(registry *validation.ConstraintRegistry) {
+	for name, factory := range builtinConstraints {
+		registry.Register(name, factory)
+	}
+}
+
+// builtinConstraints contains factories of the built-in constraints that can be used
+// in struct tags (see [validation.Struct]).
+var builtinConstraints = map[string]validation.ConstraintFactory{
+	"notblank": anyValueConstraint(
+		IsNotBlank(), IsNotBlankNumber[int64](), IsNotBlankNumber[uint64](), IsNotBlankNumber[float64](),
+	),
+	"blank": anyValueConstraint(
+		IsBlank(), IsBlankNumber[int64](), IsBlankNumber[uint64](), IsBlankNumber[float64](),
+	),
+	"notnil": anyValueConstraint(
+		IsNotNil(), IsNotNilNumber[int64](), IsNotNilNumber[uint64](), IsNotNilNumber[float64](),
+	),
+	"nil": anyValueConstraint(
+		IsNil(), IsNilNumber[int64](), IsNilNumber[uint64](), IsNilNumber[float64](),
+	),
+	"true":  boolConstraint(IsTrue()),
+	"false": boolConstraint(IsFalse()),
+
+	"length": newLengthFactory,
+	"count":  newCountFactory,
+	"min":    newMinFactory,
+	"max":    newMaxFactory,
+
+	"between":        newBetweenFactory,
+	"eq":             newEqualFactory,
+	"ne":             newNotEqualFactory,
+	"gt":             numberComparisonFactory(IsGreaterThan[int64], IsGreaterThan[uint64], IsGreaterThan[float64]),
+	"gte":            numberComparisonFactory(IsGreaterThanOrEqual[int64], IsGreaterThanOrEqual[uint64], IsGreaterThanOrEqual[float64]),
+	"lt":             numberComparisonFactory(IsLessThan[int64], IsLessThan[uint64], IsLessThan[float64]),
+	"lte":            numberComparisonFactory(IsLessThanOrEqual[int64], IsLessThanOrEqual[uint64], IsLessThanOrEqual[float64]),
+	"positive":       numberConstraint(IsPositive[int64](), IsPositive[uint64](), IsPositive[float64]()),
+	"positiveorzero": numberConstraint(IsPositiveOrZero[int64](), IsPositiveOrZero[uint64](), IsPositiveOrZero[float64]()),
+	"negative":       numberConstraint(IsNegative[int64](), IsNegative[uint64](), IsNegative[float64]()),
+	"negativeorzero": numberConstraint(IsNegativeOrZero[int64](), IsNegativeOrZero[uint64](), IsNegativeOrZero[float64]()),
+	"oneof":          newChoiceFactory,
+	"regexp":         newRegexpFactory,
+
+	"email":         stringConstraint(IsEmail()),
+	"html5email":    stringConstraint(IsHTML5Email()),
+	"strictemail":   stringConstraint(IsStrictEmail()),
+	"hostname":      stringConstraint(IsHostname()),
+	"loosehostname": stringConstraint(IsLooseHostname()),
+	"idnhostname":   stringConstraint(IsIDNHostname()),
+	"idnemail":      stringConstraint(IsIDNEmail()),
+	"url":           stringConstraint(IsURL()),
+	"ip":            stringConstraint(IsIP()),
+	"ipv4":          stringConstraint(IsIPv4()),
+	"ipv6":          stringConstraint(IsIPv6()),
+	"cidr":          stringConstraint(IsCIDR()),
+	"mac":           stringConstraint(IsMacAddress()),
+	"uuid":          stringConstraint(IsUUID()),
+	"ulid":          stringConstraint(IsULID()),
+	"json":          stringConstraint(IsJSON()),
+	"integer":       stringConstraint(IsInteger()),
+	"numeric":       stringConstraint(IsNumeric()),
+	"iban":          stringConstraint(IsIBAN()),
+	"bic":           stringConstraint(IsBIC()),
+	"isbn":          stringConstraint(IsISBN()),
+	"isin":          stringConstraint(IsISIN()),
+	"issn":          stringConstraint(IsISSN()),
+	"luhn":          stringConstraint(IsLUHN()),
+	"currency":      stringConstraint(IsCurrency()),
+	"ean8":          stringConstraint(IsEAN8()),
+	"ean13":         stringConstraint(IsEAN13()),
+	"upca":          stringConstraint(IsUPCA()),
+	"upce":          stringConstraint(IsUPCE()),
+	"date":          stringConstraint(IsDate()),
+	"datetime":      stringConstraint(IsDateTime()),
+	"time":          stringConstraint(IsTime()),
+	"nosuspicious":  stringConstraint(HasNoSuspiciousCharacters()),
+}
+
+func anyValueConstraint(stringConstraint, intConstraint, uintConstraint, floatConstraint any) validation.ConstraintFactory {
+	return func(valueType validation.ValueType, options validation.ConstraintOptions) (any, error) {
+		if !options.IsEmpty() {
+			return nil, newUnexpectedOptionsError(options)
+		}
+
+		switch valueType {
+		case validation.IntType:
+			return intConstraint, nil
+		case validation.UintType:
+			return uintConstraint, nil
+		case validation.FloatType:
+			return floatConstraint, nil
+		default:
+			return stringConstraint, nil
+		}
+	}
+}
+
+func boolConstraint(constraint validation.BoolConstraint) validation.ConstraintFactory {
+	return func(valueType validation.ValueType, options validation.ConstraintOptions) (any, error) {
+		if !options.IsEmpty() {
+			return nil, newUnexpectedOptionsError(options)
+		}
+		if valueType != validation.BoolType {
+			return nil, newUnsupportedValueTypeError(valueType)
+		}
+
+		return constraint, nil
+	}
+}
+
+func stringConstraint(constraint validation.StringConstraint) validation.ConstraintFactory {
+	return func(valueType validation.ValueType, options validation.ConstraintOptions) (any, error) {
+		if !options.IsEmpty() {
+			return nil, newUnexpectedOptionsError(options)
+		}
+		if valueType != validation.StringType {
+			return nil, newUnsupportedValueTypeError(valueType)
+		}
+
+		return constraint, nil
+	}
+}
+
+func numberConstraint(intConstraint, uintConstraint, floatConstraint any) validation.ConstraintFactory {
+	return func(valueType validation.ValueType, options validation.ConstraintOptions) (any, error) {
+		if !options.IsEmpty() {
+			return nil, newUnexpectedOptionsError(options)
+		}
+
+		switch valueType {
+		case validation.IntType:
+			return intConstraint, nil
+		case validation.UintType:
+			return uintConstraint, nil
+		case validation.FloatType:
+			return floatConstraint, nil
+		default:
+			return nil, newUnsupportedValueTypeError(valueType)
+		}
+	}
+}
+
+func numberComparisonFactory(
+	newIntConstraint func(value int64) NumberComparisonConstraint[int64],
+	newUintConstraint func(value uint64) NumberComparisonConstraint[uint64],
+	newFloatConstraint func(value float64) NumberComparisonConstraint[float64],
+) validation.ConstraintFactory {
+	return func(valueType validation.ValueType, options validation.ConstraintOptions) (any, error) {
+		switch valueType {
+		case validation.IntType:
+			value, err := parseOption[int64](options)
+			return newIntConstraint(value), err
+		case validation.UintType:
+			value, err := parseOption[uint64](options)
+			return newUintConstraint(value), err
+		case validation.FloatType:
+			value, err := parseOption[float64](options)
+			return newFloatConstraint(value), err
+		default:
+			return nil, newUnsupportedValueTypeError(valueType)
+		}
+	}
+}
+
+func newLengthFactory(valueType validation.ValueType, options validation.ConstraintOptions) (any, error) {
+	if valueType != validation.StringType {
+		return nil, newUnsupportedValueTypeError(valueType)
+	}
+	vMin, vMax, err := parseRangeOption(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return HasLengthBetween(vMin, vMax), nil
+}
+
+func newCountFactory(valueType validation.ValueType, options validation.ConstraintOptions) (any, error) {
+	if valueType != validation.CountableType {
+		return nil, newUnsupportedValueTypeError(valueType)
+	}
+	vMin, vMax, err := parseRangeOption(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return HasCountBetween(vMin, vMax), nil
+}
+
+func newMinFactory(valueType validation.ValueType, options validation.ConstraintOptions) (any, error) {
+	switch valueType {
+	case validation.StringType:
+		limit, err := parseOption[int](options)
+		return HasMinLength(limit), err
+	case validation.CountableType:
+		limit, err := parseOption[int](options)
+		return HasMinCount(limit), err
+	default:
+		return numberComparisonFactory(
+			IsGreaterThanOrEqual[int64], IsGreaterThanOrEqual[uint64], IsGreaterThanOrEqual[float64],
+		)(valueType, options)
+	}
+}
+
+func newMaxFactory(valueType validation.ValueType, options validation.ConstraintOptions) (any, error) {
+	switch valueType {
+	case validation.StringType:
+		limit, err := parseOption[int](options)
+		return HasMaxLength(limit), err
+	case validation.CountableType:
+		limit, err := parseOption[int](options)
+		return HasMaxCount(limit), err
+	default:
+		return numberComparisonFactory(
+			IsLessThanOrEqual[int64], IsLessThanOrEqual[uint64], IsLessThanOrEqual[float64],
+		)(valueType, options)
+	}
+}
+
+func newBetweenFactory(valueType validation.ValueType, options validation.ConstraintOptions) (any, error) {
+	switch valueType {
+	case validation.IntType:
+		return newNumberRange[int64](options)
+	case validation.UintType:
+		return newNumberRange[uint64](options)
+	case validation.FloatType:
+		return newNumberRange[float64](options)
+	default:
+		return nil, newUnsupportedValueTypeError(valueType)
+	}
+}
+
+func newNumberRange[T int64 | uint64 | float64](options validation.ConstraintOptions) (any, error) {
+	s, e, ok := options.Range()
+	if !ok {
+		return nil, newInvalidOptionsError(options, "expected range in format min..max")
+	}
+	vMin, err := parseOption[T](s)
+	if err != nil {
+		return nil, err
+	}
+	vMax, err := parseOption[T](e)
+	if err != nil {
+		return nil, err
+	}
+
+	return IsBetween(vMin, vMax), nil
+}
+
+func newEqualFactory(valueType validation.ValueType, options validation.ConstraintOptions) (any, error) {
+	switch valueType {
+	case validation.StringType:
+		return IsEqualTo(options.String()), nil
+	case validation.BoolType:
+		value, err := parseOption[bool](options)
+		return IsEqualTo(value), err
+	case validation.IntType:
+		value, err := parseOption[int64](options)
+		return IsEqualTo(value), err
+	case validation.UintType:
+		value, err := parseOption[uint64](options)
+		return IsEqualTo(value), err
+	case validation.FloatType:
+		value, err := parseOption[float64](options)
+		return IsEqualTo(value), err
+	default:
+		return nil, newUnsupportedValueTypeError(valueType)
+	}
+}
+
+func newNotEqualFactory(valueType validation.ValueType, options validation.ConstraintOptions) (any, error) {
+	switch valueType {
+	case validation.StringType:
+		return IsNotEqualTo(options.String()), nil
+	case validation.BoolType:
+		value, err := parseOption[bool](options)
+		return IsNotEqualTo(value), err
+	case validation.IntType:
+		value, err := parseOption[int64](options)
+		return IsNotEqualTo(value), err
+	case validation.UintType:
+		value, err := parseOption[uint64](options)
+		return IsNotEqualTo(value), err
+	case validation.FloatType:
+		value, err := parseOption[float64](options)
+		return IsNotEqualTo(value), err
+	default:
+		return nil, newUnsupportedValueTypeError(valueType)
+	}
+}
+
+func newChoiceFactory(valueType validation.ValueType, options validation.ConstraintOptions) (any, error) {
+	choices := options.List()
+	if len(choices) == 0 {
+		return nil, newInvalidOptionsError(options, "expected list of choices separated by |")
+	}
+
+	switch valueType {
+	case validation.StringType:
+		values := make([]string, len(choices))
+		for i, choice := range choices {
+			values[i] = choice.String()
+		}
+		return IsOneOf(values...), nil
+	case validation.IntType:
+		return newChoice[int64](choices)
+	case validation.UintType:
+		return newChoice[uint64](choices)
+	case validation.FloatType:
+		return newChoice[float64](choices)
+	default:
+		return nil, newUnsupportedValueTypeError(valueType)
+	}
+}
+
+func newChoice[T int64 | uint64 | float64](choices []validation.ConstraintOptions) (any, error) {
+	values := make([]T, len(choices))
+	for i, choice := range choices {
+		value, err := parseOption[T](choice)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+
+	return IsOneOf(values...), nil
+}
+
+func newRegexpFactory(valueType validation.ValueType, options validation.ConstraintOptions) (any, error) {
+	if valueType != validation.StringType {
+		return nil, newUnsupportedValueTypeError(valueType)
+	}
+	regex, err := regexp.Compile(options.String())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidOptions, err)
+	}
+
+	return Matches(regex), nil
+}
+
+// parseRangeOption parses range in format "min..max" or a single value for the exact limit.
+func parseRangeOption(options validation.ConstraintOptions) (int, int, error) {
+	s, e, isRange := options.Range()
+	vMin, err := parseOption[int](s)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !isRange {
+		return vMin, vMin, nil
+	}
+	vMax, err := parseOption[int](e)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return vMin, vMax, nil
+}
+
+func parseOption[T int | int64 | uint64 | float64 | bool](options validation.ConstraintOptions) (T, error) {
+	value, err := validation.ParseConstraintOptions[T](options)
+	if err != nil {
+		return value, newInvalidOptionsError(options, fmt.Sprintf("expected value of type %T", value))
+	}
+
+	return value, nil
+}
+
+func newUnsupportedValueTypeError(valueType validation.ValueType) error {
+	return fmt.Errorf(`%w "%s"`, errUnsupportedValueType, valueType)
+}
+
+func newUnexpectedOptionsError(options validation.ConstraintOptions) error {
+	return fmt.Errorf(`%w "%s"`, errUnexpectedOptions, options)
+}
+
+func newInvalidOptionsError(options validation.ConstraintOptions, description string) error {
+	return fmt.Errorf(`%w "%s": %s`, errInvalidOptions, options, description)
+}
@@ -28,6 +28,8 @@ type LengthConstraint struct {
 	maxMessageParameters   validation.TemplateParameterList
 	exactMessageTemplate   string
 	exactMessageParameters validation.TemplateParameterList
+	severity               validation.Severity
+	payload                any
 }
 
 func newLengthConstraint(vMin int, vMax int, checkMin bool, checkMax bool) LengthConstraint {
@@ -82,6 +84,20 @@ func (c LengthConstraint) WhenGroups(groups ...string) LengthConstraint {
 	return c
 }
 
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c LengthConstraint) WithSeverity(severity validation.Severity) LengthConstraint {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c LengthConstraint) WithPayload(payload any) LengthConstraint {
+	c.payload = payload
+	return c
+}
+
 // WithMinError overrides default underlying error for violation that will be shown if the string length
 // is less than the minimum value.
 func (c LengthConstraint) WithMinError(err error) LengthConstraint {
@@ -142,6 +158,22 @@ func (c LengthConstraint) WithExactMessage(template string, parameters ...valida
 	return c
 }
 
+// Describe returns the describable form of the constraint (see [validation.DescribableConstraint]).
+func (c LengthConstraint) Describe() validation.ConstraintDescription {
+	var description validation.ConstraintDescription
+	if c.isIgnored {
+		return description
+	}
+	if c.checkMin {
+		description.MinLength = &c.min
+	}
+	if c.checkMax {
+		description.MaxLength = &c.max
+	}
+
+	return description
+}
+
 func (c LengthConstraint) ValidateString(ctx context.Context, validator *validation.Validator, value *string) error {
 	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
 		return nil
@@ -175,6 +207,8 @@ func (c LengthConstraint) newViolation(
 	}
 
 	return validator.BuildViolation(ctx, err, template).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithPluralCount(limit).
 		WithParameters(
 			parameters.Prepend(
@@ -200,6 +234,8 @@ type RegexpConstraint struct {
 	messageTemplate   string
 	messageParameters validation.TemplateParameterList
 	regex             *regexp.Regexp
+	severity          validation.Severity
+	payload           any
 }
 
 // Matches creates a [RegexpConstraint] for checking whether a value matches a regular expression.
@@ -251,6 +287,30 @@ func (c RegexpConstraint) WhenGroups(groups ...string) RegexpConstraint {
 	return c
 }
 
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c RegexpConstraint) WithSeverity(severity validation.Severity) RegexpConstraint {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c RegexpConstraint) WithPayload(payload any) RegexpConstraint {
+	c.payload = payload
+	return c
+}
+
+// Describe returns the describable form of the constraint (see [validation.DescribableConstraint]).
+// Only the matching check can be described.
+func (c RegexpConstraint) Describe() validation.ConstraintDescription {
+	if c.isIgnored || !c.match || c.regex == nil {
+		return validation.ConstraintDescription{}
+	}
+
+	return validation.ConstraintDescription{Pattern: c.regex.String()}
+}
+
 func (c RegexpConstraint) ValidateString(ctx context.Context, validator *validation.Validator, value *string) error {
 	if c.regex == nil {
 		return validator.CreateConstraintError("RegexpConstraint", "nil regex")
@@ -264,6 +324,8 @@ func (c RegexpConstraint) ValidateString(ctx context.Context, validator *validat
 
 	return validator.
 		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithParameters(
 			c.messageParameters.Prepend(
 				validation.TemplateParameter{Key: "{{ value }}", Value: *value},
@@ -43,6 +43,8 @@ type HasNoSuspiciousCharactersConstraint struct {
 	restrictionErr        error
 	restrictionTemplate   string
 	restrictionParams     validation.TemplateParameterList
+	severity              validation.Severity
+	payload               any
 }
 
 // HasNoSuspiciousCharacters creates a constraint with default checks (invisible, mixed numbers, hidden overlay)
@@ -121,7 +123,8 @@ func (c HasNoSuspiciousCharactersConstraint) WithoutHiddenOverlay() HasNoSuspici
 }
 
 // WithSuspiciousRestriction sets script/locale restriction mode ([validate.SuspiciousRestrictionLocales],
-// [validate.SuspiciousRestrictionSingleScript], or [validate.SuspiciousRestrictionNone]).
+// [validate.SuspiciousRestrictionSingleScript], [validate.SuspiciousRestrictionHigh],
+// or [validate.SuspiciousRestrictionNone]).
 func (c HasNoSuspiciousCharactersConstraint) WithSuspiciousRestriction(r validate.SuspiciousRestriction) HasNoSuspiciousCharactersConstraint {
 	c.restriction = r
 	return c
@@ -197,6 +200,20 @@ func (c HasNoSuspiciousCharactersConstraint) WhenGroups(groups ...string) HasNoS
 	return c
 }
 
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c HasNoSuspiciousCharactersConstraint) WithSeverity(severity validation.Severity) HasNoSuspiciousCharactersConstraint {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c HasNoSuspiciousCharactersConstraint) WithPayload(payload any) HasNoSuspiciousCharactersConstraint {
+	c.payload = payload
+	return c
+}
+
 // ValidateString implements [validation.StringConstraint].
 func (c HasNoSuspiciousCharactersConstraint) ValidateString(ctx context.Context, validator *validation.Validator, value *string) error {
 	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
@@ -216,6 +233,8 @@ func (c HasNoSuspiciousCharactersConstraint) ValidateString(ctx context.Context,
 	}
 	tmpl, params, verr := c.templateForSuspiciousValidateError(err)
 	return validator.BuildViolation(ctx, verr, tmpl).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithParameters(
 			params.Prepend(
 				validation.TemplateParameter{Key: "{{ value }}", Value: *value},
@@ -4,7 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net"
+	"net/netip"
 	"net/url"
 	"regexp"
 
@@ -18,7 +18,8 @@ import (
 func IsEmail() validation.StringFuncConstraint {
 	return validation.OfStringBy(is.Email).
 		WithError(validation.ErrInvalidEmail).
-		WithMessage(validation.ErrInvalidEmail.Message())
+		WithMessage(validation.ErrInvalidEmail.Message()).
+		WithDescription(validation.ConstraintDescription{Format: "email"})
 }
 
 // IsHTML5Email is used for validation of an email address based on pattern for HTML5
@@ -26,7 +27,192 @@ func IsEmail() validation.StringFuncConstraint {
 func IsHTML5Email() validation.StringFuncConstraint {
 	return validation.OfStringBy(is.HTML5Email).
 		WithError(validation.ErrInvalidEmail).
-		WithMessage(validation.ErrInvalidEmail.Message())
+		WithMessage(validation.ErrInvalidEmail.Message()).
+		WithDescription(validation.ConstraintDescription{Format: "email"})
+}
+
+// StrictEmailConstraint is used for strict validation of an email address according to the addr-spec
+// grammar of RFC 5322 and RFC 6531 (see [validate.Email]). Unlike [IsEmail] and [IsHTML5Email], it produces
+// distinct violations for the invalid local part and the invalid domain, so the client can point
+// at the right half of the address.
+type StrictEmailConstraint struct {
+	isIgnored                  bool
+	isUTF8                     bool
+	options                    []func(*validate.EmailOptions)
+	groups                     []string
+	err                        error
+	messageTemplate            string
+	messageParameters          validation.TemplateParameterList
+	localPartErr               error
+	localPartMessageTemplate   string
+	localPartMessageParameters validation.TemplateParameterList
+	domainErr                  error
+	domainMessageTemplate      string
+	domainMessageParameters    validation.TemplateParameterList
+	severity                   validation.Severity
+	payload                    any
+}
+
+// IsStrictEmail creates a [StrictEmailConstraint] to validate an email address. It checks that:
+//   - the local part is a dot-atom ("first.last") or a quoted string ("\"john doe\"")
+//     and may be no more than 64 octets long;
+//   - the domain is a fully qualified hostname or an IP address literal
+//     ("[192.0.2.1]" or "[IPv6:2001:db8::1]");
+//   - the total length of the address must not exceed 254 characters.
+//
+// Comments are rejected by default, use [StrictEmailConstraint.AllowComments] to accept them.
+func IsStrictEmail() StrictEmailConstraint {
+	return StrictEmailConstraint{
+		err:                      validation.ErrInvalidEmail,
+		messageTemplate:          validation.ErrInvalidEmail.Message(),
+		localPartErr:             validation.ErrInvalidEmailLocalPart,
+		localPartMessageTemplate: validation.ErrInvalidEmailLocalPart.Message(),
+		domainErr:                validation.ErrInvalidEmailDomain,
+		domainMessageTemplate:    validation.ErrInvalidEmailDomain.Message(),
+	}
+}
+
+// AllowComments enables comments and folding white space around the local part
+// and the domain (e.g. "(work) user@example.com").
+func (c StrictEmailConstraint) AllowComments() StrictEmailConstraint {
+	c.options = append(c.options, validate.EmailAllowComments())
+	return c
+}
+
+// AllowUTF8 enables validation of internationalized email addresses according to RFC 6531
+// (e.g. "пользователь@пример.рф").
+func (c StrictEmailConstraint) AllowUTF8() StrictEmailConstraint {
+	c.isUTF8 = true
+	c.options = append(c.options, validate.EmailAllowUTF8())
+	return c
+}
+
+// DenyIPLiteral makes the constraint reject IP address literals as the domain (e.g. "user@[192.0.2.1]").
+func (c StrictEmailConstraint) DenyIPLiteral() StrictEmailConstraint {
+	c.options = append(c.options, validate.EmailDenyIPLiteral())
+	return c
+}
+
+// WithError overrides default error for produced violation when the value is not an email address
+// at all or is too long.
+func (c StrictEmailConstraint) WithError(err error) StrictEmailConstraint {
+	c.err = err
+	return c
+}
+
+// WithMessage sets the violation message template when the value is not an email address
+// at all or is too long. You can set custom template parameters for injecting its values
+// into the final message. Also, you can use default parameters:
+//
+//	{{ value }} - the current (invalid) value.
+func (c StrictEmailConstraint) WithMessage(template string, parameters ...validation.TemplateParameter) StrictEmailConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+	return c
+}
+
+// WithLocalPartError overrides default error for produced violation on invalid local part.
+func (c StrictEmailConstraint) WithLocalPartError(err error) StrictEmailConstraint {
+	c.localPartErr = err
+	return c
+}
+
+// WithLocalPartMessage sets the violation message template on invalid local part. You can set custom
+// template parameters for injecting its values into the final message. Also, you can use default parameters:
+//
+//	{{ value }} - the current (invalid) value.
+func (c StrictEmailConstraint) WithLocalPartMessage(template string, parameters ...validation.TemplateParameter) StrictEmailConstraint {
+	c.localPartMessageTemplate = template
+	c.localPartMessageParameters = parameters
+	return c
+}
+
+// WithDomainError overrides default error for produced violation on invalid domain.
+func (c StrictEmailConstraint) WithDomainError(err error) StrictEmailConstraint {
+	c.domainErr = err
+	return c
+}
+
+// WithDomainMessage sets the violation message template on invalid domain. You can set custom
+// template parameters for injecting its values into the final message. Also, you can use default parameters:
+//
+//	{{ value }} - the current (invalid) value.
+func (c StrictEmailConstraint) WithDomainMessage(template string, parameters ...validation.TemplateParameter) StrictEmailConstraint {
+	c.domainMessageTemplate = template
+	c.domainMessageParameters = parameters
+	return c
+}
+
+// When enables conditional validation of this constraint. If the expression evaluates to false,
+// then the constraint will be ignored.
+func (c StrictEmailConstraint) When(condition bool) StrictEmailConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+// WhenGroups enables conditional validation of the constraint by using the validation groups.
+func (c StrictEmailConstraint) WhenGroups(groups ...string) StrictEmailConstraint {
+	c.groups = groups
+	return c
+}
+
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c StrictEmailConstraint) WithSeverity(severity validation.Severity) StrictEmailConstraint {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c StrictEmailConstraint) WithPayload(payload any) StrictEmailConstraint {
+	c.payload = payload
+	return c
+}
+
+// Describe returns the describable form of the constraint (see [validation.DescribableConstraint]).
+func (c StrictEmailConstraint) Describe() validation.ConstraintDescription {
+	if c.isIgnored {
+		return validation.ConstraintDescription{}
+	}
+	if c.isUTF8 {
+		return validation.ConstraintDescription{Format: "idn-email"}
+	}
+
+	return validation.ConstraintDescription{Format: "email"}
+}
+
+func (c StrictEmailConstraint) ValidateString(ctx context.Context, validator *validation.Validator, value *string) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	err := validate.Email(*value, c.options...)
+	if err == nil {
+		return nil
+	}
+
+	violationErr, template, parameters := c.err, c.messageTemplate, c.messageParameters
+	if errors.Is(err, validate.ErrInvalidEmailLocalPart) {
+		violationErr, template, parameters = c.localPartErr, c.localPartMessageTemplate, c.localPartMessageParameters
+	} else if errors.Is(err, validate.ErrInvalidEmailDomain) {
+		violationErr, template, parameters = c.domainErr, c.domainMessageTemplate, c.domainMessageParameters
+	}
+
+	return validator.BuildViolation(ctx, violationErr, template).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
+		WithParameters(
+			parameters.Prepend(
+				validation.TemplateParameter{Key: "{{ value }}", Value: *value},
+			)...,
+		).
+		Create()
+}
+
+// Validate implements [validation.Constraint][string] so the constraint can be used with [validation.Each] and [validation.This].
+func (c StrictEmailConstraint) Validate(ctx context.Context, validator *validation.Validator, v string) error {
+	return c.ValidateString(ctx, validator, &v)
 }
 
 // IsHostname validates that a value is a valid hostname. It checks that:
@@ -42,7 +228,8 @@ func IsHTML5Email() validation.StringFuncConstraint {
 func IsHostname() validation.StringFuncConstraint {
 	return validation.OfStringBy(is.StrictHostname).
 		WithError(validation.ErrInvalidHostname).
-		WithMessage(validation.ErrInvalidHostname.Message())
+		WithMessage(validation.ErrInvalidHostname.Message()).
+		WithDescription(validation.ConstraintDescription{Format: "hostname"})
 }
 
 // IsLooseHostname validates that a value is a valid hostname. It checks that:
@@ -51,7 +238,182 @@ func IsHostname() validation.StringFuncConstraint {
 func IsLooseHostname() validation.StringFuncConstraint {
 	return validation.OfStringBy(is.Hostname).
 		WithError(validation.ErrInvalidHostname).
-		WithMessage(validation.ErrInvalidHostname.Message())
+		WithMessage(validation.ErrInvalidHostname.Message()).
+		WithDescription(validation.ConstraintDescription{Format: "hostname"})
+}
+
+// IDNConstraint is used to validate internationalized hostnames and email addresses
+// according to IDNA 2008 and Unicode Technical Standard #46. Use [IsIDNHostname], [IsLooseIDNHostname],
+// or [IsIDNEmail] to create the constraint. Use [validate.IDNHostname] or [validate.IDNEmail]
+// to get the normalized ASCII-compatible (punycode) form of the value.
+type IDNConstraint struct {
+	isIgnored                   bool
+	isEmail                     bool
+	options                     []func(*validate.IDNOptions)
+	groups                      []string
+	err                         error
+	messageTemplate             string
+	messageParameters           validation.TemplateParameterList
+	suspiciousErr               error
+	suspiciousMessageTemplate   string
+	suspiciousMessageParameters validation.TemplateParameterList
+	severity                    validation.Severity
+	payload                     any
+}
+
+// IsIDNHostname creates an [IDNConstraint] to validate an internationalized hostname
+// (e.g. "пример.рф" or "xn--e1afmkfd.xn--p1ai"). It checks that:
+//   - each label is valid according to IDNA 2008 and UTS #46 and may be no more than 63 octets long;
+//   - the total length of the ASCII-compatible form of the hostname must not exceed 253 characters;
+//   - hostname is fully qualified and include its top-level domain name;
+//   - checks for reserved top-level domains according to RFC 2606
+//     (.example, .invalid, .localhost, and .test).
+//
+// If you do not want to check for top-level domains use [IsLooseIDNHostname] version of constraint.
+func IsIDNHostname() IDNConstraint {
+	return newIDNConstraint(validation.ErrInvalidHostname, validate.IDNRequireTLD())
+}
+
+// IsLooseIDNHostname creates an [IDNConstraint] to validate an internationalized hostname
+// without checking for the top-level domain.
+func IsLooseIDNHostname() IDNConstraint {
+	return newIDNConstraint(validation.ErrInvalidHostname)
+}
+
+// IsIDNEmail creates an [IDNConstraint] to validate an internationalized email address (RFC 6531).
+// The local part may contain UTF-8 characters, and the domain part is validated as an internationalized
+// hostname (e.g. "пользователь@пример.рф").
+func IsIDNEmail() IDNConstraint {
+	c := newIDNConstraint(validation.ErrInvalidEmail)
+	c.isEmail = true
+	return c
+}
+
+func newIDNConstraint(err *validation.Error, options ...func(*validate.IDNOptions)) IDNConstraint {
+	return IDNConstraint{
+		options:                   options,
+		err:                       err,
+		messageTemplate:           err.Message(),
+		suspiciousErr:             validation.ErrSuspiciousCharactersRestriction,
+		suspiciousMessageTemplate: validation.ErrSuspiciousCharactersRestriction.Message(),
+	}
+}
+
+// DenyMixedScripts enables the check of each domain label for the mixed scripts to reject
+// homograph domains (e.g. "pаypal.com" with the Cyrillic "а"). You can pass options
+// to customize the checks (see [validate.IDNDenyMixedScripts]).
+func (c IDNConstraint) DenyMixedScripts(options ...validate.NoSuspiciousCharactersOption) IDNConstraint {
+	c.options = append(c.options, validate.IDNDenyMixedScripts(options...))
+	return c
+}
+
+// WithError overrides default error for produced violation on invalid value.
+func (c IDNConstraint) WithError(err error) IDNConstraint {
+	c.err = err
+	return c
+}
+
+// WithMessage sets the violation message template on invalid value. You can set custom template parameters
+// for injecting its values into the final message. Also, you can use default parameters:
+//
+//	{{ value }} - the current (invalid) value.
+func (c IDNConstraint) WithMessage(template string, parameters ...validation.TemplateParameter) IDNConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+	return c
+}
+
+// WithSuspiciousError overrides default error for produced violation on suspicious domain
+// (see [IDNConstraint.DenyMixedScripts]).
+func (c IDNConstraint) WithSuspiciousError(err error) IDNConstraint {
+	c.suspiciousErr = err
+	return c
+}
+
+// WithSuspiciousMessage sets the violation message template on suspicious domain
+// (see [IDNConstraint.DenyMixedScripts]). You can set custom template parameters
+// for injecting its values into the final message. Also, you can use default parameters:
+//
+//	{{ value }} - the current (invalid) value.
+func (c IDNConstraint) WithSuspiciousMessage(template string, parameters ...validation.TemplateParameter) IDNConstraint {
+	c.suspiciousMessageTemplate = template
+	c.suspiciousMessageParameters = parameters
+	return c
+}
+
+// When enables conditional validation of this constraint. If the expression evaluates to false,
+// then the constraint will be ignored.
+func (c IDNConstraint) When(condition bool) IDNConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+// WhenGroups enables conditional validation of the constraint by using the validation groups.
+func (c IDNConstraint) WhenGroups(groups ...string) IDNConstraint {
+	c.groups = groups
+	return c
+}
+
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c IDNConstraint) WithSeverity(severity validation.Severity) IDNConstraint {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c IDNConstraint) WithPayload(payload any) IDNConstraint {
+	c.payload = payload
+	return c
+}
+
+// Describe returns the describable form of the constraint (see [validation.DescribableConstraint]).
+func (c IDNConstraint) Describe() validation.ConstraintDescription {
+	if c.isIgnored {
+		return validation.ConstraintDescription{}
+	}
+	if c.isEmail {
+		return validation.ConstraintDescription{Format: "idn-email"}
+	}
+
+	return validation.ConstraintDescription{Format: "idn-hostname"}
+}
+
+func (c IDNConstraint) ValidateString(ctx context.Context, validator *validation.Validator, value *string) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	var err error
+	if c.isEmail {
+		_, err = validate.IDNEmail(*value, c.options...)
+	} else {
+		_, err = validate.IDNHostname(*value, c.options...)
+	}
+	if err == nil {
+		return nil
+	}
+
+	violationErr, template, parameters := c.err, c.messageTemplate, c.messageParameters
+	if !errors.Is(err, validate.ErrInvalid) {
+		violationErr, template, parameters = c.suspiciousErr, c.suspiciousMessageTemplate, c.suspiciousMessageParameters
+	}
+
+	return validator.BuildViolation(ctx, violationErr, template).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
+		WithParameters(
+			parameters.Prepend(
+				validation.TemplateParameter{Key: "{{ value }}", Value: *value},
+			)...,
+		).
+		Create()
+}
+
+// Validate implements [validation.Constraint][string] so the constraint can be used with [validation.Each] and [validation.This].
+func (c IDNConstraint) Validate(ctx context.Context, validator *validation.Validator, v string) error {
+	return c.ValidateString(ctx, validator, &v)
 }
 
 // URLConstraint is used to validate URL string. This constraint doesn’t check that the host of the
@@ -65,6 +427,8 @@ type URLConstraint struct {
 	hosts                       []string
 	hostPattern                 *regexp.Regexp
 	restrictions                []func(u *url.URL) error
+	denyInternalTargets         bool
+	resolver                    validate.HostResolver
 	groups                      []string
 	invalidErr                  error
 	prohibitedErr               error
@@ -72,6 +436,8 @@ type URLConstraint struct {
 	invalidMessageParameters    validation.TemplateParameterList
 	prohibitedMessageTemplate   string
 	prohibitedMessageParameters validation.TemplateParameterList
+	severity                    validation.Severity
+	payload                     any
 }
 
 // IsURL creates a [URLConstraint] to validate an URL. By default, constraint checks
@@ -129,6 +495,32 @@ func (c URLConstraint) WithRestriction(isAllowed func(u *url.URL) bool) URLConst
 	return c
 }
 
+// DenyInternalTargets denies URLs that can be used for server-side request forgery (SSRF),
+// for example, user-provided webhook URLs. URLs with the user information (e.g. "http://user@example.com")
+// and URLs with the internal hosts are treated as prohibited. The internal hosts are "localhost" names
+// and literal IP addresses (including the alternative IPv4 encodings like "2130706433" and IPv4-mapped
+// IPv6 addresses) from the loopback, private, link-local (including cloud metadata endpoints), CGNAT,
+// multicast, and other RFC 6890 special-purpose ranges (see [validate.IsInternalIP]).
+//
+// Host names are not resolved by default. Use [URLConstraint.WithResolver] to apply the same policy
+// to the IP addresses the host name is resolved to.
+func (c URLConstraint) DenyInternalTargets() URLConstraint {
+	c.denyInternalTargets = true
+	return c
+}
+
+// WithResolver enables the [URLConstraint.DenyInternalTargets] policy and resolves the host names
+// of the URLs by the given resolver (for example, [net.DefaultResolver]) to check the resolved IP addresses.
+// Host names that cannot be resolved are treated as prohibited. The context error is returned as is.
+//
+// Note that the host may be resolved into another IP address by the time of the request (DNS rebinding),
+// so the HTTP client should check the IP address of the established connection too.
+func (c URLConstraint) WithResolver(resolver validate.HostResolver) URLConstraint {
+	c.denyInternalTargets = true
+	c.resolver = resolver
+	return c
+}
+
 // WithError overrides default error for produced violation.
 func (c URLConstraint) WithError(err error) URLConstraint {
 	c.invalidErr = err
@@ -175,6 +567,32 @@ func (c URLConstraint) WhenGroups(groups ...string) URLConstraint {
 	return c
 }
 
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c URLConstraint) WithSeverity(severity validation.Severity) URLConstraint {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c URLConstraint) WithPayload(payload any) URLConstraint {
+	c.payload = payload
+	return c
+}
+
+// Describe returns the describable form of the constraint (see [validation.DescribableConstraint]).
+func (c URLConstraint) Describe() validation.ConstraintDescription {
+	if c.isIgnored {
+		return validation.ConstraintDescription{}
+	}
+	if c.supportsRelativeSchema {
+		return validation.ConstraintDescription{Format: "uri-reference"}
+	}
+
+	return validation.ConstraintDescription{Format: "uri"}
+}
+
 func (c URLConstraint) ValidateString(ctx context.Context, validator *validation.Validator, value *string) error {
 	if len(c.schemas) == 0 {
 		return validator.CreateConstraintError("URLConstraint", "empty list of schemas")
@@ -190,6 +608,9 @@ func (c URLConstraint) ValidateString(ctx context.Context, validator *validation
 
 		return c.newInvalidViolation(ctx, validator, *value)
 	}
+	if c.resolver != nil {
+		return c.validateResolvedHost(ctx, validator, *value)
+	}
 
 	return nil
 }
@@ -212,13 +633,32 @@ func (c URLConstraint) getRestrictions() []func(u *url.URL) error {
 	if c.hostPattern != nil {
 		restrictions = append(restrictions, validate.RestrictURLHostByPattern(c.hostPattern))
 	}
+	if c.denyInternalTargets {
+		restrictions = append(restrictions, validate.DenyInternalURLTargets())
+	}
 	restrictions = append(restrictions, c.restrictions...)
 
 	return restrictions
 }
 
+func (c URLConstraint) validateResolvedHost(ctx context.Context, validator *validation.Validator, value string) error {
+	u, err := url.Parse(value)
+	if err != nil {
+		return c.newInvalidViolation(ctx, validator, value)
+	}
+
+	err = validate.DenyInternalResolvedHost(ctx, c.resolver, u.Hostname())
+	if errors.Is(err, validate.ErrProhibited) {
+		return c.newProhibitedViolation(ctx, validator, value)
+	}
+
+	return err
+}
+
 func (c URLConstraint) newInvalidViolation(ctx context.Context, validator *validation.Validator, value string) error {
 	return validator.BuildViolation(ctx, c.invalidErr, c.invalidMessageTemplate).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithParameters(
 			c.invalidMessageParameters.Prepend(
 				validation.TemplateParameter{Key: "{{ value }}", Value: value},
@@ -229,6 +669,8 @@ func (c URLConstraint) newInvalidViolation(ctx context.Context, validator *valid
 
 func (c URLConstraint) newProhibitedViolation(ctx context.Context, validator *validation.Validator, value string) error {
 	return validator.BuildViolation(ctx, c.prohibitedErr, c.prohibitedMessageTemplate).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithParameters(
 			c.prohibitedMessageParameters.Prepend(
 				validation.TemplateParameter{Key: "{{ value }}", Value: value},
@@ -238,45 +680,65 @@ func (c URLConstraint) newProhibitedViolation(ctx context.Context, validator *va
 }
 
 // IPConstraint is used to validate IP address. You can check for different versions
-// and restrict some ranges by additional options.
+// and restrict some ranges by additional options. The constraint can be applied to strings
+// and to already parsed [netip.Addr] values (see [validation.Comparable]).
+//
+// IPv6 addresses with a zone (e.g. "fe80::1%eth0") are invalid by default,
+// use [IPConstraint.AllowZone] to accept them.
 type IPConstraint struct {
 	isIgnored    bool
-	validate     func(value string, restrictions ...func(ip net.IP) error) error
-	restrictions []func(ip net.IP) error
+	allowsZone   bool
+	format       string
+	isVersion    func(ip netip.Addr) bool
+	restrictions []func(ip netip.Addr) error
+	inSubnets    func(ip netip.Addr) error
 
 	groups []string
 
-	invalidErr    error
-	prohibitedErr error
-
-	invalidMessageTemplate      string
-	invalidMessageParameters    validation.TemplateParameterList
-	prohibitedMessageTemplate   string
-	prohibitedMessageParameters validation.TemplateParameterList
+	invalidErr     error
+	prohibitedErr  error
+	notInSubnetErr error
+
+	invalidMessageTemplate       string
+	invalidMessageParameters     validation.TemplateParameterList
+	prohibitedMessageTemplate    string
+	prohibitedMessageParameters  validation.TemplateParameterList
+	notInSubnetMessageTemplate   string
+	notInSubnetMessageParameters validation.TemplateParameterList
+	severity                     validation.Severity
+	payload                      any
 }
 
 // IsIP creates an IPConstraint to validate an IP address (IPv4 or IPv6).
 func IsIP() IPConstraint {
-	return newIPConstraint(validate.IP)
+	return newIPConstraint(func(ip netip.Addr) bool { return true })
 }
 
 // IsIPv4 creates an IPConstraint to validate an IPv4 address.
 func IsIPv4() IPConstraint {
-	return newIPConstraint(validate.IPv4)
+	c := newIPConstraint(netip.Addr.Is4)
+	c.format = "ipv4"
+
+	return c
 }
 
-// IsIPv6 creates an IPConstraint to validate an IPv4 address.
+// IsIPv6 creates an IPConstraint to validate an IPv6 address.
 func IsIPv6() IPConstraint {
-	return newIPConstraint(validate.IPv6)
+	c := newIPConstraint(netip.Addr.Is6)
+	c.format = "ipv6"
+
+	return c
 }
 
-func newIPConstraint(validate func(value string, restrictions ...func(ip net.IP) error) error) IPConstraint {
+func newIPConstraint(isVersion func(ip netip.Addr) bool) IPConstraint {
 	return IPConstraint{
-		validate:                  validate,
-		invalidErr:                validation.ErrInvalidIP,
-		prohibitedErr:             validation.ErrProhibitedIP,
-		invalidMessageTemplate:    validation.ErrInvalidIP.Message(),
-		prohibitedMessageTemplate: validation.ErrProhibitedIP.Message(),
+		isVersion:                  isVersion,
+		invalidErr:                 validation.ErrInvalidIP,
+		prohibitedErr:              validation.ErrProhibitedIP,
+		notInSubnetErr:             validation.ErrIPNotInSubnet,
+		invalidMessageTemplate:     validation.ErrInvalidIP.Message(),
+		prohibitedMessageTemplate:  validation.ErrProhibitedIP.Message(),
+		notInSubnetMessageTemplate: validation.ErrIPNotInSubnet.Message(),
 	}
 }
 
@@ -287,9 +749,62 @@ func (c IPConstraint) DenyPrivateIP() IPConstraint {
 	return c
 }
 
+// DenyLoopback denies using of loopback IPs (127.0.0.0/8 and ::1).
+func (c IPConstraint) DenyLoopback() IPConstraint {
+	c.restrictions = append(c.restrictions, validate.DenyLoopback())
+	return c
+}
+
+// DenyLinkLocal denies using of link-local unicast and multicast IPs
+// (169.254.0.0/16, 224.0.0.0/24, fe80::/10, ff02::/16).
+func (c IPConstraint) DenyLinkLocal() IPConstraint {
+	c.restrictions = append(c.restrictions, validate.DenyLinkLocal())
+	return c
+}
+
+// DenyMulticast denies using of multicast IPs (224.0.0.0/4 and ff00::/8).
+func (c IPConstraint) DenyMulticast() IPConstraint {
+	c.restrictions = append(c.restrictions, validate.DenyMulticast())
+	return c
+}
+
+// DenyReserved denies using of unspecified IPs and other special-purpose IPs
+// from the RFC 6890 registries (see [validate.DenyReserved]).
+func (c IPConstraint) DenyReserved() IPConstraint {
+	c.restrictions = append(c.restrictions, validate.DenyReserved())
+	return c
+}
+
+// DenyPrefixes denies using of IPs contained in any of the given prefixes.
+func (c IPConstraint) DenyPrefixes(prefixes ...netip.Prefix) IPConstraint {
+	c.restrictions = append(c.restrictions, validate.DenyPrefixes(prefixes...))
+	return c
+}
+
+// AllowOnlyPrefixes denies using of IPs that are not contained in any of the given prefixes.
+func (c IPConstraint) AllowOnlyPrefixes(prefixes ...netip.Prefix) IPConstraint {
+	c.restrictions = append(c.restrictions, validate.AllowOnlyPrefixes(prefixes...))
+	return c
+}
+
+// InSubnets checks that the IP address belongs to any of the given subnets, for example,
+// that the gateway address belongs to the network. Unlike [IPConstraint.AllowOnlyPrefixes], it produces
+// a violation with [validation.ErrIPNotInSubnet] instead of the prohibited IP violation.
+func (c IPConstraint) InSubnets(subnets ...netip.Prefix) IPConstraint {
+	c.inSubnets = validate.AllowOnlyPrefixes(subnets...)
+	return c
+}
+
+// AllowZone enables support of IPv6 addresses with a zone (e.g. "fe80::1%eth0").
+// The restrictions check the address without the zone.
+func (c IPConstraint) AllowZone() IPConstraint {
+	c.allowsZone = true
+	return c
+}
+
 // DenyIP can be used to deny custom range of IP addresses.
-func (c IPConstraint) DenyIP(restrict func(ip net.IP) bool) IPConstraint {
-	c.restrictions = append(c.restrictions, func(ip net.IP) error {
+func (c IPConstraint) DenyIP(restrict func(ip netip.Addr) bool) IPConstraint {
+	c.restrictions = append(c.restrictions, func(ip netip.Addr) error {
 		if restrict(ip) {
 			return validate.ErrProhibited
 		}
@@ -333,6 +848,24 @@ func (c IPConstraint) WithProhibitedMessage(template string, parameters ...valid
 	return c
 }
 
+// WithNotInSubnetError overrides default underlying error for violation produced
+// when the IP address does not belong to the subnets (see [IPConstraint.InSubnets]).
+func (c IPConstraint) WithNotInSubnetError(err error) IPConstraint {
+	c.notInSubnetErr = err
+	return c
+}
+
+// WithNotInSubnetMessage sets the violation message template when the IP address does not belong
+// to the subnets (see [IPConstraint.InSubnets]). You can set custom template parameters for injecting
+// its values into the final message. Also, you can use default parameters:
+//
+//	{{ value }} - the current (invalid) value.
+func (c IPConstraint) WithNotInSubnetMessage(template string, parameters ...validation.TemplateParameter) IPConstraint {
+	c.notInSubnetMessageTemplate = template
+	c.notInSubnetMessageParameters = parameters
+	return c
+}
+
 // When enables conditional validation of this constraint. If the expression evaluates to false,
 // then the constraint will be ignored.
 func (c IPConstraint) When(condition bool) IPConstraint {
@@ -346,20 +879,75 @@ func (c IPConstraint) WhenGroups(groups ...string) IPConstraint {
 	return c
 }
 
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c IPConstraint) WithSeverity(severity validation.Severity) IPConstraint {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c IPConstraint) WithPayload(payload any) IPConstraint {
+	c.payload = payload
+	return c
+}
+
+// Describe returns the describable form of the constraint (see [validation.DescribableConstraint]).
+func (c IPConstraint) Describe() validation.ConstraintDescription {
+	if c.isIgnored {
+		return validation.ConstraintDescription{}
+	}
+
+	return validation.ConstraintDescription{Format: c.format}
+}
+
 func (c IPConstraint) ValidateString(ctx context.Context, validator *validation.Validator, value *string) error {
 	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
 		return nil
 	}
 
-	return c.validateIP(ctx, validator, *value)
+	ip, err := netip.ParseAddr(*value)
+	if err != nil {
+		return c.newViolation(ctx, validator, validate.ErrInvalid, *value)
+	}
+
+	return c.validateIP(ctx, validator, ip, *value)
 }
 
-func (c IPConstraint) validateIP(ctx context.Context, validator *validation.Validator, value string) error {
-	err := c.validate(value, c.restrictions...)
-	if err == nil {
+// ValidateComparable implements [validation.ComparableConstraint][netip.Addr] so the constraint can be used
+// to validate already parsed addresses by [validation.Comparable]. The zero value is ignored.
+func (c IPConstraint) ValidateComparable(ctx context.Context, validator *validation.Validator, value *netip.Addr) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || !value.IsValid() {
 		return nil
 	}
 
+	return c.validateIP(ctx, validator, *value, value.String())
+}
+
+func (c IPConstraint) validateIP(ctx context.Context, validator *validation.Validator, ip netip.Addr, value string) error {
+	if !c.isVersion(ip) || (!c.allowsZone && ip.Zone() != "") {
+		return c.newViolation(ctx, validator, validate.ErrInvalid, value)
+	}
+	if err := validate.IPAddrWithZone(ip, c.restrictions...); err != nil {
+		return c.newViolation(ctx, validator, err, value)
+	}
+	if c.inSubnets != nil && c.inSubnets(ip) != nil {
+		return validator.BuildViolation(ctx, c.notInSubnetErr, c.notInSubnetMessageTemplate).
+			WithSeverity(c.severity).
+			WithPayload(c.payload).
+			WithParameters(
+				c.notInSubnetMessageParameters.Prepend(
+					validation.TemplateParameter{Key: "{{ value }}", Value: value},
+				)...,
+			).
+			Create()
+	}
+
+	return nil
+}
+
+func (c IPConstraint) newViolation(ctx context.Context, validator *validation.Validator, err error, value string) error {
 	var builder *validation.ViolationBuilder
 	var parameters validation.TemplateParameterList
 
@@ -372,6 +960,8 @@ func (c IPConstraint) validateIP(ctx context.Context, validator *validation.Vali
 	}
 
 	return builder.
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithParameters(
 			parameters.Prepend(
 				validation.TemplateParameter{Key: "{{ value }}", Value: value},
@@ -393,25 +983,41 @@ func (c IPConstraint) Validate(ctx context.Context, validator *validation.Valida
 //
 // Use [CIDRConstraint.IPv4Only], [CIDRConstraint.IPv6Only], or [CIDRConstraint.WithVersion] to restrict
 // the IP version. Use [CIDRConstraint.WithNetmaskRange] to set the allowed inclusive prefix range.
+// Use [CIDRConstraint.WithinNetworks] and [CIDRConstraint.DenyOverlapping] to check the network
+// against the allowed supernets and the deny list.
 type CIDRConstraint struct {
-	isIgnored          bool
-	groups             []string
-	options            []func(*validate.CIDROptions)
-	invalidErr         error
-	outOfRangeErr      error
-	invalidTemplate    string
-	outOfRangeTemplate string
-	invalidParams      validation.TemplateParameterList
-	outOfRangeParams   validation.TemplateParameterList
+	isIgnored             bool
+	groups                []string
+	options               []func(*validate.CIDROptions)
+	allowedNetworks       []netip.Prefix
+	deniedNetworks        []netip.Prefix
+	invalidErr            error
+	outOfRangeErr         error
+	notInNetworksErr      error
+	overlapErr            error
+	invalidTemplate       string
+	outOfRangeTemplate    string
+	notInNetworksTemplate string
+	overlapTemplate       string
+	invalidParams         validation.TemplateParameterList
+	outOfRangeParams      validation.TemplateParameterList
+	notInNetworksParams   validation.TemplateParameterList
+	overlapParams         validation.TemplateParameterList
+	severity              validation.Severity
+	payload               any
 }
 
 // IsCIDR creates a [CIDRConstraint] that accepts IPv4 and IPv6 CIDR notation.
 func IsCIDR() CIDRConstraint {
 	return CIDRConstraint{
-		invalidErr:         validation.ErrInvalidCIDR,
-		outOfRangeErr:      validation.ErrCIDRNetmaskOutOfRange,
-		invalidTemplate:    validation.ErrInvalidCIDR.Message(),
-		outOfRangeTemplate: validation.ErrCIDRNetmaskOutOfRange.Message(),
+		invalidErr:            validation.ErrInvalidCIDR,
+		outOfRangeErr:         validation.ErrCIDRNetmaskOutOfRange,
+		notInNetworksErr:      validation.ErrCIDRNotInNetworks,
+		overlapErr:            validation.ErrCIDROverlap,
+		invalidTemplate:       validation.ErrInvalidCIDR.Message(),
+		outOfRangeTemplate:    validation.ErrCIDRNetmaskOutOfRange.Message(),
+		notInNetworksTemplate: validation.ErrCIDRNotInNetworks.Message(),
+		overlapTemplate:       validation.ErrCIDROverlap.Message(),
 	}
 }
 
@@ -439,6 +1045,20 @@ func (c CIDRConstraint) WithNetmaskRange(netmaskMin, netmaskMax int) CIDRConstra
 	return c
 }
 
+// WithinNetworks checks that the network is entirely contained in any of the given supernets,
+// for example, a subnet of a VPC must be within the VPC network. The host bits of the value are ignored.
+func (c CIDRConstraint) WithinNetworks(networks ...netip.Prefix) CIDRConstraint {
+	c.allowedNetworks = networks
+	return c
+}
+
+// DenyOverlapping checks that the network has no addresses in common with any of the given networks,
+// for example, with the networks that are already in use.
+func (c CIDRConstraint) DenyOverlapping(networks ...netip.Prefix) CIDRConstraint {
+	c.deniedNetworks = networks
+	return c
+}
+
 // WithInvalidError overrides the error for invalid CIDR notation or version mismatch.
 func (c CIDRConstraint) WithInvalidError(err error) CIDRConstraint {
 	c.invalidErr = err
@@ -465,6 +1085,41 @@ func (c CIDRConstraint) WithOutOfRangeMessage(template string, parameters ...val
 	return c
 }
 
+// WithNotInNetworksError overrides the error for a network that is not within the allowed networks.
+func (c CIDRConstraint) WithNotInNetworksError(err error) CIDRConstraint {
+	c.notInNetworksErr = err
+	return c
+}
+
+// WithNotInNetworksMessage sets the violation message template when the network is not within the allowed networks.
+// You can set custom template parameters for injecting its values into the final message.
+// Also, you can use default parameters:
+//
+//	{{ value }} - the current (invalid) value.
+func (c CIDRConstraint) WithNotInNetworksMessage(template string, parameters ...validation.TemplateParameter) CIDRConstraint {
+	c.notInNetworksTemplate = template
+	c.notInNetworksParams = parameters
+	return c
+}
+
+// WithOverlapError overrides the error for a network overlapping with the denied network.
+func (c CIDRConstraint) WithOverlapError(err error) CIDRConstraint {
+	c.overlapErr = err
+	return c
+}
+
+// WithOverlapMessage sets the violation message template when the network overlaps with the denied network.
+// You can set custom template parameters for injecting its values into the final message.
+// Also, you can use default parameters:
+//
+//	{{ network }} - the denied network;
+//	{{ value }} - the current (invalid) value.
+func (c CIDRConstraint) WithOverlapMessage(template string, parameters ...validation.TemplateParameter) CIDRConstraint {
+	c.overlapTemplate = template
+	c.overlapParams = parameters
+	return c
+}
+
 // When enables conditional validation of this constraint. If the expression evaluates to false,
 // then the constraint will be ignored.
 func (c CIDRConstraint) When(condition bool) CIDRConstraint {
@@ -478,6 +1133,20 @@ func (c CIDRConstraint) WhenGroups(groups ...string) CIDRConstraint {
 	return c
 }
 
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c CIDRConstraint) WithSeverity(severity validation.Severity) CIDRConstraint {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c CIDRConstraint) WithPayload(payload any) CIDRConstraint {
+	c.payload = payload
+	return c
+}
+
 func (c CIDRConstraint) ValidateString(ctx context.Context, validator *validation.Validator, value *string) error {
 	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
 		return nil
@@ -485,12 +1154,14 @@ func (c CIDRConstraint) ValidateString(ctx context.Context, validator *validatio
 
 	err := validate.CIDR(*value, c.options...)
 	if err == nil {
-		return nil
+		return c.validateNetwork(ctx, validator, *value)
 	}
 
 	if errors.Is(err, validate.ErrCIDRNetmaskOutOfRange) {
 		netmaskLo, netmaskHi := validate.CIDRViolationNetmaskBounds(*value, c.options...)
 		return validator.BuildViolation(ctx, c.outOfRangeErr, c.outOfRangeTemplate).
+			WithSeverity(c.severity).
+			WithPayload(c.payload).
 			WithParameters(
 				c.outOfRangeParams.Prepend(
 					validation.TemplateParameter{Key: "{{ max }}", Value: fmt.Sprint(netmaskHi)},
@@ -502,6 +1173,8 @@ func (c CIDRConstraint) ValidateString(ctx context.Context, validator *validatio
 	}
 
 	return validator.BuildViolation(ctx, c.invalidErr, c.invalidTemplate).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithParameters(
 			c.invalidParams.Prepend(
 				validation.TemplateParameter{Key: "{{ value }}", Value: *value},
@@ -510,11 +1183,156 @@ func (c CIDRConstraint) ValidateString(ctx context.Context, validator *validatio
 		Create()
 }
 
+func (c CIDRConstraint) validateNetwork(ctx context.Context, validator *validation.Validator, value string) error {
+	if len(c.allowedNetworks) == 0 && len(c.deniedNetworks) == 0 {
+		return nil
+	}
+	prefix, err := validate.ParseCIDR(value)
+	if err != nil {
+		return nil
+	}
+	prefix = prefix.Masked()
+
+	if len(c.allowedNetworks) > 0 && !validate.PrefixWithin(prefix, c.allowedNetworks...) {
+		return validator.BuildViolation(ctx, c.notInNetworksErr, c.notInNetworksTemplate).
+			WithSeverity(c.severity).
+			WithPayload(c.payload).
+			WithParameters(
+				c.notInNetworksParams.Prepend(
+					validation.TemplateParameter{Key: "{{ value }}", Value: value},
+				)...,
+			).
+			Create()
+	}
+	if network, overlaps := validate.OverlappingPrefix(prefix, c.deniedNetworks...); overlaps {
+		return validator.BuildViolation(ctx, c.overlapErr, c.overlapTemplate).
+			WithSeverity(c.severity).
+			WithPayload(c.payload).
+			WithParameters(
+				c.overlapParams.Prepend(
+					validation.TemplateParameter{Key: "{{ network }}", Value: network.String()},
+					validation.TemplateParameter{Key: "{{ value }}", Value: value},
+				)...,
+			).
+			Create()
+	}
+
+	return nil
+}
+
 // Validate implements [validation.Constraint][string] so the constraint can be used with [validation.Each] and [validation.This].
 func (c CIDRConstraint) Validate(ctx context.Context, validator *validation.Validator, v string) error {
 	return c.ValidateString(ctx, validator, &v)
 }
 
+// NoOverlappingCIDRsConstraint is used to check that the networks in the given slice of CIDR notations
+// do not overlap with each other, for example, the subnets of a VPC. Each overlapping element is reported
+// at its [validation.ArrayIndex]. Elements that are not valid CIDR notations are ignored,
+// use [validation.Each] with [IsCIDR] to check them. Use [HasNoOverlappingCIDRs] to create it.
+// The constraint implements [validation.SliceConstraint][string].
+type NoOverlappingCIDRsConstraint struct {
+	isIgnored         bool
+	groups            []string
+	err               error
+	messageTemplate   string
+	messageParameters validation.TemplateParameterList
+	severity          validation.Severity
+	payload           any
+}
+
+// HasNoOverlappingCIDRs checks that the networks in the given slice of CIDR notations do not overlap
+// with each other. Use with [validation.Slice] or [validation.SliceProperty].
+func HasNoOverlappingCIDRs() NoOverlappingCIDRsConstraint {
+	return NoOverlappingCIDRsConstraint{
+		err:             validation.ErrCIDROverlap,
+		messageTemplate: validation.ErrCIDROverlap.Message(),
+	}
+}
+
+// WithError overrides default error for produced violation.
+func (c NoOverlappingCIDRsConstraint) WithError(err error) NoOverlappingCIDRsConstraint {
+	c.err = err
+	return c
+}
+
+// WithMessage sets the violation message template. You can set custom template parameters
+// for injecting its values into the final message. Also, you can use default parameters:
+//
+//	{{ network }} - the first other element overlapping with the current one;
+//	{{ value }} - the current (invalid) value.
+func (c NoOverlappingCIDRsConstraint) WithMessage(template string, parameters ...validation.TemplateParameter) NoOverlappingCIDRsConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+	return c
+}
+
+// When enables conditional validation of this constraint. If the expression evaluates to false,
+// then the constraint will be ignored.
+func (c NoOverlappingCIDRsConstraint) When(condition bool) NoOverlappingCIDRsConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+// WhenGroups enables conditional validation of the constraint by using the validation groups.
+func (c NoOverlappingCIDRsConstraint) WhenGroups(groups ...string) NoOverlappingCIDRsConstraint {
+	c.groups = groups
+	return c
+}
+
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c NoOverlappingCIDRsConstraint) WithSeverity(severity validation.Severity) NoOverlappingCIDRsConstraint {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c NoOverlappingCIDRsConstraint) WithPayload(payload any) NoOverlappingCIDRsConstraint {
+	c.payload = payload
+	return c
+}
+
+// ValidateSlice implements [validation.SliceConstraint][string].
+func (c NoOverlappingCIDRsConstraint) ValidateSlice(ctx context.Context, validator *validation.Validator, items []string) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) {
+		return nil
+	}
+
+	prefixes := make([]netip.Prefix, len(items))
+	for i, item := range items {
+		if prefix, err := validate.ParseCIDR(item); err == nil {
+			prefixes[i] = prefix.Masked()
+		}
+	}
+
+	builder := validator.BuildViolationList(ctx)
+	for i, prefix := range prefixes {
+		if !prefix.IsValid() {
+			continue
+		}
+		for j, other := range prefixes {
+			if i == j || !other.IsValid() || !prefix.Overlaps(other) {
+				continue
+			}
+			builder.BuildViolation(c.err, c.messageTemplate).
+				WithSeverity(c.severity).
+				WithPayload(c.payload).
+				AtIndex(i).
+				WithParameters(
+					c.messageParameters.Prepend(
+						validation.TemplateParameter{Key: "{{ network }}", Value: items[j]},
+						validation.TemplateParameter{Key: "{{ value }}", Value: items[i]},
+					)...,
+				).
+				Add()
+			break
+		}
+	}
+
+	return violationsError(builder.Create())
+}
+
 // MacAddressConstraint validates a string as a 48-bit MAC address, aligned with
 // Symfony\Component\Validator\Constraints\MacAddress.
 //
@@ -528,6 +1346,8 @@ type MacAddressConstraint struct {
 	err               error
 	messageTemplate   string
 	messageParameters validation.TemplateParameterList
+	severity          validation.Severity
+	payload           any
 }
 
 // IsMacAddress creates a [MacAddressConstraint] with default type [validate.MacAddressTypeAll].
@@ -581,6 +1401,20 @@ func (c MacAddressConstraint) WhenGroups(groups ...string) MacAddressConstraint
 	return c
 }
 
+// WithSeverity sets the level of the produced violation (see [validation.Severity]).
+// Violations with the non-blocking level (warning or notice) are reported, but do not fail the validation.
+func (c MacAddressConstraint) WithSeverity(severity validation.Severity) MacAddressConstraint {
+	c.severity = severity
+	return c
+}
+
+// WithPayload attaches the arbitrary data to the produced violation (see [validation.PayloadOf]), for example,
+// a link to the documentation or a hint for the user interface.
+func (c MacAddressConstraint) WithPayload(payload any) MacAddressConstraint {
+	c.payload = payload
+	return c
+}
+
 func (c MacAddressConstraint) ValidateString(ctx context.Context, validator *validation.Validator, value *string) error {
 	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
 		return nil
@@ -589,6 +1423,8 @@ func (c MacAddressConstraint) ValidateString(ctx context.Context, validator *val
 		return nil
 	}
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithSeverity(c.severity).
+		WithPayload(c.payload).
 		WithParameters(
 			c.messageParameters.Prepend(
 				validation.TemplateParameter{Key: "{{ value }}", Value: *value},
@@ -0,0 +1,170 @@
+package validation
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// JSONSchemaDialect is the URI of the JSON Schema dialect used by [Validator.DescribeJSONSchema].
+const JSONSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// JSONSchema is a JSON Schema (draft 2020-12) document that can be generated from the validation arguments
+// by the [Validator.DescribeJSONSchema] method. It can be encoded into JSON by the [encoding/json] package.
+type JSONSchema struct {
+	Schema           string                 `json:"$schema,omitempty"`
+	Type             string                 `json:"type,omitempty"`
+	Format           string                 `json:"format,omitempty"`
+	Pattern          string                 `json:"pattern,omitempty"`
+	MinLength        *int                   `json:"minLength,omitempty"`
+	MaxLength        *int                   `json:"maxLength,omitempty"`
+	Minimum          any                    `json:"minimum,omitempty"`
+	Maximum          any                    `json:"maximum,omitempty"`
+	ExclusiveMinimum any                    `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum any                    `json:"exclusiveMaximum,omitempty"`
+	MultipleOf       any                    `json:"multipleOf,omitempty"`
+	Enum             []any                  `json:"enum,omitempty"`
+	MinItems         *int                   `json:"minItems,omitempty"`
+	MaxItems         *int                   `json:"maxItems,omitempty"`
+	UniqueItems      bool                   `json:"uniqueItems,omitempty"`
+	Items            *JSONSchema            `json:"items,omitempty"`
+	Properties       map[string]*JSONSchema `json:"properties,omitempty"`
+	Required         []string               `json:"required,omitempty"`
+}
+
+// DescribeJSONSchema runs the validator in the introspection mode: instead of validating the values,
+// the constraints of the arguments are described as a JSON Schema document. Property paths of the arguments
+// are converted into nested "properties" and "items" keywords.
+//
+// Only constraints implementing the [DescribableConstraint] interface are described. Nested [Validatable]
+// values, flow control arguments, and conditional arguments are processed the same way as during
+// the validation, so the document describes only the branches that would be validated for the
+// given values. Slices of [Validatable] values are described by their elements, so pass at least one element
+// to describe the "items" keyword. Violations from the arguments that cannot be described are ignored.
+func (validator *Validator) DescribeJSONSchema(ctx context.Context, arguments ...Argument) (*JSONSchema, error) {
+	builder := &jsonSchemaBuilder{root: &JSONSchema{}}
+	describer := validator.copy()
+	describer.schema = builder
+
+	err := describer.Validate(ctx, arguments...)
+	if _, ok := UnwrapViolations(err); err != nil && !ok {
+		return nil, err
+	}
+
+	builder.root.Schema = JSONSchemaDialect
+
+	return builder.root, nil
+}
+
+// jsonSchemaBuilder collects descriptions of the arguments into the JSON Schema document.
+type jsonSchemaBuilder struct {
+	mu   sync.Mutex
+	root *JSONSchema
+}
+
+func (builder *jsonSchemaBuilder) describe(path *PropertyPath, description *argumentDescription) {
+	builder.mu.Lock()
+	defer builder.mu.Unlock()
+
+	parent := (*JSONSchema)(nil)
+	node := builder.root
+	var last PropertyPathElement
+	for _, element := range path.All() {
+		parent = node
+		last = element
+		node = node.child(element)
+	}
+
+	target := node
+	if description.isItems {
+		target = node.child(ArrayIndex(0))
+	}
+	if target.Type == "" {
+		target.Type = description.valueType
+	}
+	if description.format != "" {
+		target.Format = description.format
+	}
+
+	for _, constraint := range description.constraints {
+		target.apply(constraint)
+		if constraint.Required && !description.isItems && parent != nil {
+			if name, ok := last.(PropertyName); ok && !slices.Contains(parent.Required, string(name)) {
+				parent.Required = append(parent.Required, string(name))
+			}
+		}
+	}
+}
+
+func (schema *JSONSchema) child(element PropertyPathElement) *JSONSchema {
+	if element.IsIndex() {
+		schema.Type = "array"
+		if schema.Items == nil {
+			schema.Items = &JSONSchema{}
+		}
+		return schema.Items
+	}
+
+	schema.Type = "object"
+	if schema.Properties == nil {
+		schema.Properties = make(map[string]*JSONSchema)
+	}
+	name := element.String()
+	if schema.Properties[name] == nil {
+		schema.Properties[name] = &JSONSchema{}
+	}
+
+	return schema.Properties[name]
+}
+
+func (schema *JSONSchema) apply(description ConstraintDescription) {
+	if description.Format != "" {
+		schema.Format = description.Format
+	}
+	if description.Pattern != "" {
+		schema.Pattern = description.Pattern
+	}
+	schema.MinLength = maxLimit(schema.MinLength, description.MinLength)
+	schema.MaxLength = minLimit(schema.MaxLength, description.MaxLength)
+	schema.MinItems = maxLimit(schema.MinItems, description.MinItems)
+	schema.MaxItems = minLimit(schema.MaxItems, description.MaxItems)
+	if description.Minimum != nil {
+		schema.Minimum = description.Minimum
+	}
+	if description.Maximum != nil {
+		schema.Maximum = description.Maximum
+	}
+	if description.ExclusiveMinimum != nil {
+		schema.ExclusiveMinimum = description.ExclusiveMinimum
+	}
+	if description.ExclusiveMaximum != nil {
+		schema.ExclusiveMaximum = description.ExclusiveMaximum
+	}
+	if description.MultipleOf != nil {
+		schema.MultipleOf = description.MultipleOf
+	}
+	if description.Enum != nil {
+		schema.Enum = description.Enum
+	}
+	if description.UniqueItems {
+		schema.UniqueItems = true
+	}
+}
+
+func maxLimit(current, limit *int) *int {
+	if limit == nil || current != nil && *current >= *limit {
+		return current
+	}
+	value := *limit
+
+	return &value
+}
+
+func minLimit(current, limit *int) *int {
+	if limit == nil || current != nil && *current <= *limit {
+		return current
+	}
+	value := *limit
+
+	return &value
+}
@@ -0,0 +1,50 @@
+package validation
+
+import "sync/atomic"
+
+// violationLimit holds the state of the maximum number of violations shared by all validators
+// of the single validation run (see [MaxViolations]).
+type violationLimit struct {
+	max   int
+	count atomic.Int64
+	// isStopped is set when the validation of the remaining values is skipped because of the limit
+	isStopped atomic.Bool
+}
+
+// apply truncates the violations by the limit.
+func (limit *violationLimit) apply(violations *ViolationList) {
+	if violations.len > limit.max {
+		violations.truncate(limit.max)
+	}
+	if limit.isStopped.Load() {
+		violations.isTruncated = true
+	}
+}
+
+// isLimitReached reports whether the maximum number of violations is collected during the validation run.
+// It must be called before the validation of the next value: if the limit is reached,
+// the run is marked as stopped and the resulting violation list will be truncated.
+func (validator *Validator) isLimitReached() bool {
+	if validator.run == nil || validator.run.limit == nil {
+		return false
+	}
+	limit := validator.run.limit
+	if limit.count.Load() < int64(limit.max) {
+		return false
+	}
+	limit.isStopped.Store(true)
+
+	return true
+}
+
+// discardViolations excludes the violations discarded by the validation process from the count.
+func (validator *Validator) discardViolations(n int) {
+	if validator.run != nil && validator.run.limit != nil && n != 0 {
+		validator.run.limit.count.Add(-int64(n))
+	}
+}
+
+// restoreViolations counts the previously discarded violations again.
+func (validator *Validator) restoreViolations(n int) {
+	validator.discardViolations(-n)
+}
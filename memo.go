@@ -0,0 +1,135 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// validatableAncestor is the chain of the pointers to [Validatable] values being validated
+// in the current call chain. It is passed via the context to detect cyclic references.
+type validatableAncestor struct {
+	value  any
+	path   *PropertyPath
+	parent *validatableAncestor
+}
+
+type validatableAncestorKey struct{}
+
+// memoEntry holds the result of the validation of the [Validatable] value within the validation run.
+type memoEntry struct {
+	// path is the property path of the value at the moment of the validation
+	path       *PropertyPath
+	violations []Violation
+	err        error
+}
+
+// validateIt validates the [Validatable] value. If the value is a pointer, then it is checked for
+// cyclic references, and the result of the validation is memoized if it is enabled for the validator
+// (see [Validator.WithMemoization]).
+func (validator *Validator) validateIt(ctx context.Context, value Validatable) error {
+	key, ok := pointerOf(value)
+	if !ok {
+		return value.Validate(ctx, validator)
+	}
+
+	ancestor, _ := ctx.Value(validatableAncestorKey{}).(*validatableAncestor)
+	for a := ancestor; a != nil; a = a.parent {
+		if a.value == key {
+			return validator.CreateConstraintError(
+				"Valid",
+				fmt.Sprintf(`cyclic reference: %T is already being validated at path "%s"`, value, a.path.String()),
+			)
+		}
+	}
+	ctx = context.WithValue(ctx, validatableAncestorKey{}, &validatableAncestor{
+		value:  key,
+		path:   validator.propertyPath,
+		parent: ancestor,
+	})
+
+	if !validator.memoize || validator.run == nil {
+		return value.Validate(ctx, validator)
+	}
+	if entry, ok := validator.run.memoized(key); ok {
+		return entry.emit(ctx, validator)
+	}
+
+	err := value.Validate(ctx, validator)
+	entry := &memoEntry{path: validator.propertyPath}
+	if violations, ok := UnwrapViolations(err); ok {
+		entry.violations = violations.AsSlice()
+	} else {
+		entry.err = err
+	}
+	validator.run.memoize(key, entry)
+
+	return err
+}
+
+// emit creates the memoized violations at the property path of the validator.
+func (entry *memoEntry) emit(ctx context.Context, validator *Validator) error {
+	if entry.err != nil {
+		return entry.err
+	}
+
+	violations := NewViolationList()
+	for _, violation := range entry.violations {
+		builder := validator.BuildViolation(ctx, violation.Unwrap(), violation.MessageTemplate()).
+			WithParameters(UnsafeParameters(violation)...).
+			SetPropertyPath(validator.propertyPath.With(entry.relativePath(violation.PropertyPath())...)).
+			WithSeverity(SeverityOf(violation)).
+			WithPayload(PayloadOf(violation))
+		if v, ok := violation.(interface{ PluralCount() int }); ok {
+			builder = builder.WithPluralCount(v.PluralCount())
+		}
+		violations.Append(builder.Create())
+	}
+
+	return violations.asError()
+}
+
+// relativePath returns the elements of the violation path relative to the path of the memoized value.
+func (entry *memoEntry) relativePath(path *PropertyPath) []PropertyPathElement {
+	var elements []PropertyPathElement
+	for p := path; p != nil && p != entry.path; p = p.parent {
+		if p.value != nil {
+			elements = append(elements, p.value)
+		}
+	}
+	slices.Reverse(elements)
+
+	return elements
+}
+
+func (run *validationRun) memoized(key any) (*memoEntry, bool) {
+	run = run.root()
+	run.mu.Lock()
+	defer run.mu.Unlock()
+
+	entry, ok := run.memo[key]
+
+	return entry, ok
+}
+
+func (run *validationRun) memoize(key any, entry *memoEntry) {
+	run = run.root()
+	run.mu.Lock()
+	defer run.mu.Unlock()
+
+	if run.memo == nil {
+		run.memo = make(map[any]*memoEntry)
+	}
+	run.memo[key] = entry
+}
+
+// pointerOf returns the value as a key of the memo if it is a non-nil pointer.
+func pointerOf(value Validatable) (any, bool) {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return nil, false
+	}
+
+	return value, true
+}
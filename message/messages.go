@@ -32,6 +32,8 @@ const (
 	InvalidEAN13             = "This value is not a valid EAN-13."
 	InvalidEAN8              = "This value is not a valid EAN-8."
 	InvalidEmail             = "This value is not a valid email address."
+	InvalidEmailLocalPart    = "The local part of this email address is not valid."
+	InvalidEmailDomain       = "The domain of this email address is not valid."
 	InvalidHostname          = "This value is not a valid hostname."
 	InvalidIBAN              = "This is not a valid International Bank Account Number (IBAN)."
 	InvalidBIC               = "This is not a valid Business Identifier Code (BIC)."
@@ -44,11 +46,16 @@ const (
 	InvalidCurrency          = "This value is not a valid currency."
 	InvalidCIDR              = "This value is not a valid CIDR notation."
 	CIDRNetmaskOutOfRange    = "The value of the netmask should be between {{ min }} and {{ max }}."
+	CIDRNotInNetworks        = "This network is not within the allowed networks."
+	CIDROverlap              = "This network overlaps with the network {{ network }}."
 	InvalidIP                = "This is not a valid IP address."
+	IPNotInSubnet            = "This IP address does not belong to the allowed subnets."
+	InvalidFormat            = "This value does not match the {{ format }} format."
 	InvalidJSON              = "This value should be valid JSON."
 	InvalidLUHN              = "Invalid card number."
 	InvalidMAC               = "This value is not a valid MAC address."
 	InvalidTime              = "This value is not a valid time."
+	InvalidType              = "This value should be of type {{ type }}."
 	InvalidULID              = "This is not a valid ULID."
 	InvalidUPCA              = "This value is not a valid UPC-A."
 	InvalidUPCE              = "This value is not a valid UPC-E."
@@ -57,6 +64,7 @@ const (
 	IsBlank                  = "This value should not be blank."
 	IsEqual                  = "This value should not be equal to {{ comparedValue }}."
 	IsNil                    = "This value should not be nil."
+	MissingProperty          = "This field is missing."
 	NoSuchChoice             = "The value you selected is not a valid choice."
 	NotBlank                 = "This value should be blank."
 	NotDivisible             = "This value should be a multiple of {{ comparedValue }}."
@@ -90,6 +98,7 @@ const (
 	TooLowOrEqual            = "This value should be greater than or equal to {{ comparedValue }}."
 	TooManyElements          = "This collection should contain {{ limit }} element(s) or less."
 	TooShort                 = "This value is too short. It should have {{ limit }} character(s) or more."
+	UnexpectedProperty       = "This field was not expected."
 
 	// NoSuspiciousCharacters (Symfony Validator wording).
 	SuspiciousInvisible             = "Using invisible characters is not allowed."
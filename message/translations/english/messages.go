@@ -53,6 +53,8 @@ var Messages = map[language.Tag]map[string]catalog.Message{
 		message.InvalidEAN13:             catalog.String(message.InvalidEAN13),
 		message.InvalidEAN8:              catalog.String(message.InvalidEAN8),
 		message.InvalidEmail:             catalog.String(message.InvalidEmail),
+		message.InvalidEmailLocalPart:    catalog.String(message.InvalidEmailLocalPart),
+		message.InvalidEmailDomain:       catalog.String(message.InvalidEmailDomain),
 		message.InvalidHostname:          catalog.String(message.InvalidHostname),
 		message.InvalidIBAN:              catalog.String(message.InvalidIBAN),
 		message.InvalidBIC:               catalog.String(message.InvalidBIC),
@@ -65,11 +67,18 @@ var Messages = map[language.Tag]map[string]catalog.Message{
 		message.InvalidCurrency:          catalog.String(message.InvalidCurrency),
 		message.InvalidCIDR:              catalog.String(message.InvalidCIDR),
 		message.CIDRNetmaskOutOfRange:    catalog.String(message.CIDRNetmaskOutOfRange),
+		message.CIDRNotInNetworks:        catalog.String(message.CIDRNotInNetworks),
+		message.CIDROverlap:              catalog.String(message.CIDROverlap),
 		message.InvalidIP:                catalog.String(message.InvalidIP),
+		message.IPNotInSubnet:            catalog.String(message.IPNotInSubnet),
+		message.InvalidFormat:            catalog.String(message.InvalidFormat),
 		message.InvalidJSON:              catalog.String(message.InvalidJSON),
 		message.InvalidLUHN:              catalog.String(message.InvalidLUHN),
 		message.InvalidMAC:               catalog.String(message.InvalidMAC),
 		message.InvalidTime:              catalog.String(message.InvalidTime),
+		message.InvalidType:              catalog.String(message.InvalidType),
+		message.MissingProperty:          catalog.String(message.MissingProperty),
+		message.UnexpectedProperty:       catalog.String(message.UnexpectedProperty),
 		message.InvalidULID:              catalog.String(message.InvalidULID),
 		message.InvalidUPCA:              catalog.String(message.InvalidUPCA),
 		message.InvalidUPCE:              catalog.String(message.InvalidUPCE),
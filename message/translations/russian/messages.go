@@ -56,6 +56,8 @@ var Messages = map[language.Tag]map[string]catalog.Message{
 		message.InvalidEAN13:             catalog.String("Значение не является допустимым EAN-13."),
 		message.InvalidEAN8:              catalog.String("Значение не является допустимым EAN-8."),
 		message.InvalidEmail:             catalog.String("Значение адреса электронной почты недопустимо."),
+		message.InvalidEmailLocalPart:    catalog.String("Локальная часть адреса электронной почты недопустима."),
+		message.InvalidEmailDomain:       catalog.String("Домен адреса электронной почты недопустим."),
 		message.InvalidHostname:          catalog.String("Значение не является корректным именем хоста."),
 		message.InvalidIBAN:              catalog.String("Значение не является допустимым международным номером банковского счёта (IBAN)."),
 		message.InvalidBIC:               catalog.String("Значение не является допустимым банковским идентификатором (BIC)."),
@@ -68,11 +70,18 @@ var Messages = map[language.Tag]map[string]catalog.Message{
 		message.InvalidCurrency:          catalog.String("Значение не является допустимым кодом валюты."),
 		message.InvalidCIDR:              catalog.String("Значение не является допустимой записью CIDR."),
 		message.CIDRNetmaskOutOfRange:    catalog.String("Значение маски сети должно быть между {{ min }} и {{ max }}."),
+		message.CIDRNotInNetworks:        catalog.String("Эта сеть не входит в разрешённые сети."),
+		message.CIDROverlap:              catalog.String("Эта сеть пересекается с сетью {{ network }}."),
 		message.InvalidIP:                catalog.String("Значение не является допустимым IP адресом."),
+		message.IPNotInSubnet:            catalog.String("Этот IP-адрес не принадлежит разрешённым подсетям."),
+		message.InvalidFormat:            catalog.String("Значение не соответствует формату {{ format }}."),
 		message.InvalidJSON:              catalog.String("Значение должно быть корректным JSON."),
 		message.InvalidLUHN:              catalog.String("Недействительный номер карты."),
 		message.InvalidMAC:               catalog.String("Значение не является допустимым MAC-адресом."),
 		message.InvalidTime:              catalog.String("Значение времени недопустимо."),
+		message.InvalidType:              catalog.String("Тип значения должен быть {{ type }}."),
+		message.MissingProperty:          catalog.String("Это поле отсутствует."),
+		message.UnexpectedProperty:       catalog.String("Это поле не ожидалось."),
 		message.InvalidULID:              catalog.String("Значение не соответствует формату ULID."),
 		message.InvalidUPCA:              catalog.String("Значение не является допустимым UPC-A."),
 		message.InvalidUPCE:              catalog.String("Значение не является допустимым UPC-E."),
@@ -0,0 +1,290 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"sync"
+	"time"
+)
+
+// Observer receives the events of the validation process. It can be used to trace the validation,
+// to collect metrics of slow or frequently violated constraints, or to log the validation process.
+// Use the [SetObserver] option to set up the observer. The package provides adapters for
+// the [log/slog] package ([SlogObserver]) and a simple in-memory counter ([CountingObserver]).
+//
+// The observer must be safe for concurrent use, because the arguments can be validated
+// in parallel (see [Async] and [EachParallel]).
+type Observer interface {
+	// ArgumentStarted is called before the validation of the argument (including the arguments
+	// of the nested validation). The event contains only the property path of the argument.
+	ArgumentStarted(ctx context.Context, event ArgumentEvent)
+	// ArgumentFinished is called after the validation of the argument.
+	ArgumentFinished(ctx context.Context, event ArgumentEvent)
+	// ConstraintEvaluated is called after the evaluation of the constraint.
+	ConstraintEvaluated(ctx context.Context, event ConstraintEvent)
+	// ViolationCreated is called for each violation created by the violation factory of the validator.
+	// The context is the context passed to the [Validator.Validate] method.
+	ViolationCreated(ctx context.Context, violation Violation)
+	// ErrorReturned is called when the validation process is stopped by the error
+	// that is not a violation (for example, [ConstraintError] or an error of the I/O operation).
+	ErrorReturned(ctx context.Context, event ErrorEvent)
+}
+
+// ArgumentEvent describes the validation of the argument passed to the [Validator.Validate] method.
+type ArgumentEvent struct {
+	// PropertyPath is the path of the validated argument.
+	PropertyPath *PropertyPath
+	// Duration is the time spent on the validation of the argument.
+	Duration time.Duration
+	// Violations is the number of violations of the argument.
+	Violations int
+	// Err is the error stopped the validation of the argument, nil for violations.
+	Err error
+}
+
+// ConstraintEvent describes the evaluation of the constraint.
+type ConstraintEvent struct {
+	// Constraint is the type name of the constraint, for example, "it.NotBlankConstraint[string]".
+	Constraint string
+	// PropertyPath is the path of the validated value.
+	PropertyPath *PropertyPath
+	// Duration is the time spent on the evaluation of the constraint.
+	Duration time.Duration
+	// Err is the result of the evaluation: nil, violation, violation list, or another error.
+	Err error
+}
+
+// ErrorEvent describes the error stopped the validation process.
+type ErrorEvent struct {
+	// PropertyPath is the path where the error occurred, if it is known
+	// (see [ConstraintError] and [PanicError]).
+	PropertyPath *PropertyPath
+	Err          error
+}
+
+func newErrorEvent(validator *Validator, err error) ErrorEvent {
+	event := ErrorEvent{PropertyPath: validator.propertyPath, Err: err}
+	var constraintErr *ConstraintError
+	var panicErr *PanicError
+	if errors.As(err, &constraintErr) {
+		event.PropertyPath = constraintErr.Path
+	} else if errors.As(err, &panicErr) {
+		event.PropertyPath = panicErr.Path
+	}
+
+	return event
+}
+
+// ViolationCodes returns the codes of the underlying errors of the violations contained in the error.
+// It can be used to get codes from the result of the constraint evaluation (see [ConstraintEvent]).
+func ViolationCodes(err error) []string {
+	violations, ok := UnwrapViolations(err)
+	if !ok {
+		return nil
+	}
+
+	codes := make([]string, 0, violations.Len())
+	for _, violation := range violations.All() {
+		codes = append(codes, violationCode(violation))
+	}
+
+	return codes
+}
+
+func violationCode(violation Violation) string {
+	if err := violation.Unwrap(); err != nil {
+		return err.Error()
+	}
+
+	return ""
+}
+
+// validateArgument runs the validation of the argument and notifies the observer.
+func (validator *Validator) validateArgument(ctx context.Context, validate ValidateFunc) (*ViolationList, error) {
+	if validator.observer == nil {
+		return validate(ctx, validator)
+	}
+
+	validator.observer.ArgumentStarted(ctx, ArgumentEvent{PropertyPath: validator.propertyPath})
+	start := time.Now()
+	violations, err := validate(ctx, validator)
+	validator.observer.ArgumentFinished(ctx, ArgumentEvent{
+		PropertyPath: validator.propertyPath,
+		Duration:     time.Since(start),
+		Violations:   violations.Len(),
+		Err:          err,
+	})
+
+	return violations, err
+}
+
+// evaluate calls the validation function of the constraint and notifies the observer.
+func (validator *Validator) evaluate(ctx context.Context, constraint any, validate func() error) error {
+	if validator.observer == nil {
+		return validate()
+	}
+
+	start := time.Now()
+	err := validate()
+	validator.observer.ConstraintEvaluated(ctx, ConstraintEvent{
+		Constraint:   fmt.Sprintf("%T", constraint),
+		PropertyPath: validator.propertyPath,
+		Duration:     time.Since(start),
+		Err:          err,
+	})
+
+	return err
+}
+
+// SlogObserver is the [Observer] that logs the events of the validation process by the [slog.Logger].
+// Arguments, constraints, and violations are logged at the [slog.LevelDebug] level,
+// errors are logged at the [slog.LevelError] level.
+type SlogObserver struct {
+	logger *slog.Logger
+}
+
+// NewSlogObserver creates the [SlogObserver] logging by the logger. If the logger is nil,
+// then [slog.Default] is used.
+func NewSlogObserver(logger *slog.Logger) *SlogObserver {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &SlogObserver{logger: logger}
+}
+
+func (observer *SlogObserver) ArgumentStarted(ctx context.Context, event ArgumentEvent) {
+	observer.logger.DebugContext(ctx, "validation argument started",
+		slog.String("path", event.PropertyPath.String()),
+	)
+}
+
+func (observer *SlogObserver) ArgumentFinished(ctx context.Context, event ArgumentEvent) {
+	observer.logger.DebugContext(ctx, "validation argument finished",
+		slog.String("path", event.PropertyPath.String()),
+		slog.Duration("duration", event.Duration),
+		slog.Int("violations", event.Violations),
+	)
+}
+
+func (observer *SlogObserver) ConstraintEvaluated(ctx context.Context, event ConstraintEvent) {
+	observer.logger.DebugContext(ctx, "validation constraint evaluated",
+		slog.String("constraint", event.Constraint),
+		slog.String("path", event.PropertyPath.String()),
+		slog.Duration("duration", event.Duration),
+		slog.Any("codes", ViolationCodes(event.Err)),
+	)
+}
+
+func (observer *SlogObserver) ViolationCreated(ctx context.Context, violation Violation) {
+	observer.logger.DebugContext(ctx, "validation violation created",
+		slog.String("path", violation.PropertyPath().String()),
+		slog.String("code", violationCode(violation)),
+	)
+}
+
+func (observer *SlogObserver) ErrorReturned(ctx context.Context, event ErrorEvent) {
+	observer.logger.ErrorContext(ctx, "validation failed with error",
+		slog.String("path", event.PropertyPath.String()),
+		slog.String("error", event.Err.Error()),
+	)
+}
+
+// ConstraintStats holds the statistics of the constraint collected by the [CountingObserver].
+type ConstraintStats struct {
+	// Evaluations is the number of evaluations of the constraint.
+	Evaluations int
+	// Violations is the number of violations produced by the constraint.
+	Violations int
+	// Duration is the total time spent on the evaluations of the constraint.
+	Duration time.Duration
+}
+
+// CountingObserver is the [Observer] that counts the events of the validation process in memory.
+// It can be used in tests or to export the metrics periodically. Use [NewCountingObserver] to create it.
+type CountingObserver struct {
+	mu          sync.Mutex
+	arguments   int
+	errors      int
+	constraints map[string]ConstraintStats
+	violations  map[string]int
+}
+
+// NewCountingObserver creates the [CountingObserver].
+func NewCountingObserver() *CountingObserver {
+	return &CountingObserver{
+		constraints: make(map[string]ConstraintStats),
+		violations:  make(map[string]int),
+	}
+}
+
+func (observer *CountingObserver) ArgumentStarted(ctx context.Context, event ArgumentEvent) {}
+
+func (observer *CountingObserver) ArgumentFinished(ctx context.Context, event ArgumentEvent) {
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+
+	observer.arguments++
+}
+
+func (observer *CountingObserver) ConstraintEvaluated(ctx context.Context, event ConstraintEvent) {
+	violations, _ := UnwrapViolations(event.Err)
+
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+
+	stats := observer.constraints[event.Constraint]
+	stats.Evaluations++
+	stats.Violations += violations.Len()
+	stats.Duration += event.Duration
+	observer.constraints[event.Constraint] = stats
+}
+
+func (observer *CountingObserver) ViolationCreated(ctx context.Context, violation Violation) {
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+
+	observer.violations[violationCode(violation)]++
+}
+
+func (observer *CountingObserver) ErrorReturned(ctx context.Context, event ErrorEvent) {
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+
+	observer.errors++
+}
+
+// Arguments returns the number of validated arguments.
+func (observer *CountingObserver) Arguments() int {
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+
+	return observer.arguments
+}
+
+// Errors returns the number of errors stopped the validation process.
+func (observer *CountingObserver) Errors() int {
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+
+	return observer.errors
+}
+
+// Constraints returns the statistics of the evaluated constraints by their type names.
+func (observer *CountingObserver) Constraints() map[string]ConstraintStats {
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+
+	return maps.Clone(observer.constraints)
+}
+
+// Violations returns the number of created violations by their error codes.
+func (observer *CountingObserver) Violations() map[string]int {
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+
+	return maps.Clone(observer.violations)
+}
@@ -44,6 +44,18 @@ func (a ArrayIndex) String() string {
 	return strconv.Itoa(int(a))
 }
 
+// wildcard is an element of the document selector matching any element of the array or the map
+// (see [Document]). It is never used in the paths of violations.
+type wildcard struct{}
+
+func (wildcard) IsIndex() bool {
+	return true
+}
+
+func (wildcard) String() string {
+	return "*"
+}
+
 // PropertyPath is generated by the validator and indicates how it reached the invalid value
 // from the root element. Property path is denoted by dots, while array access
 // is denoted by square brackets. For example, "book.keywords[0]" means that the violation
@@ -246,6 +258,8 @@ const (
 	endBracketedNameState
 
 	closeBracketState
+
+	wildcardState
 )
 
 type pathParser struct {
@@ -255,6 +269,9 @@ type pathParser struct {
 	index     int
 	pathIndex int
 	path      *PropertyPath
+
+	// allowWildcard enables the "[*]" element matching any element of the array or the map.
+	allowWildcard bool
 }
 
 func (parser *pathParser) Parse(encodedPath string) (*PropertyPath, error) {
@@ -296,7 +313,7 @@ func (parser *pathParser) handleNext(c rune) error {
 
 func (parser *pathParser) handleOpenBracket(c rune) error {
 	switch parser.state {
-	case beginIdentifierState, beginIndexState, indexState, endBracketedNameState:
+	case beginIdentifierState, beginIndexState, indexState, endBracketedNameState, wildcardState:
 		return parser.newCharError(c, "unexpected char")
 	case identifierState:
 		if parser.buffer.Len() > 0 {
@@ -325,6 +342,10 @@ func (parser *pathParser) handleCloseBracket(c rune) error {
 	case endBracketedNameState:
 		parser.addProperty()
 		parser.state = closeBracketState
+	case wildcardState:
+		parser.path = &PropertyPath{parent: parser.path, value: wildcard{}}
+		parser.pathIndex++
+		parser.state = closeBracketState
 	default:
 		return parser.newCharError(c, "unexpected close bracket")
 	}
@@ -403,7 +424,13 @@ func (parser *pathParser) handleEscape(c rune) error {
 
 func (parser *pathParser) handleOther(c rune) error {
 	switch parser.state {
-	case beginIndexState, indexState:
+	case beginIndexState:
+		if c == '*' && parser.allowWildcard {
+			parser.state = wildcardState
+			return nil
+		}
+		return parser.newCharError(c, "unexpected array index character")
+	case indexState, wildcardState:
 		return parser.newCharError(c, "unexpected array index character")
 	case initialState, beginIdentifierState, identifierState:
 		if !isFirstIdentifierChar(c) {
@@ -452,7 +479,7 @@ func (parser *pathParser) finish() (*PropertyPath, error) {
 			return nil, parser.newError("incomplete property name")
 		}
 		parser.path = parser.path.WithProperty(parser.buffer.String())
-	case beginIndexState, indexState:
+	case beginIndexState, indexState, wildcardState:
 		return nil, parser.newError("incomplete array index")
 	case bracketedNameState, endBracketedNameState:
 		return nil, parser.newError("incomplete bracketed property name")
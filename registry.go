@@ -0,0 +1,193 @@
+package validation
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ConstraintFactory creates a constraint by its options for the value of the given type.
+// It is used to resolve constraints by name from the [ConstraintRegistry], for example,
+// from the struct tags used by [Struct] argument.
+//
+// The returned value must implement one of the constraint interfaces applicable to the value type:
+// [StringConstraint], [BoolConstraint], [NumberConstraint] (of int64, uint64 or float64),
+// [ComparableConstraint], [TimeConstraint], [CountableConstraint] or [NilConstraint].
+type ConstraintFactory func(valueType ValueType, options ConstraintOptions) (any, error)
+
+// ConstraintRegistry is used to store constraint factories under string names, so the constraints
+// can be resolved dynamically from struct tags, configuration files, or admin UIs.
+// It is safe for concurrent use.
+//
+// By default, the validator uses the registry returned by [DefaultConstraintRegistry].
+// Use the [SetConstraintRegistry] option to inject your own registry into the validator.
+type ConstraintRegistry struct {
+	mu        sync.RWMutex
+	factories map[string]ConstraintFactory
+}
+
+// NewConstraintRegistry creates an empty [ConstraintRegistry]. Built-in constraints can be registered by
+// the [github.com/muonsoft/validation/it.RegisterConstraints] function.
+func NewConstraintRegistry() *ConstraintRegistry {
+	return &ConstraintRegistry{factories: make(map[string]ConstraintFactory)}
+}
+
+var defaultConstraintRegistry = NewConstraintRegistry()
+
+// DefaultConstraintRegistry returns the registry that is used by the validator by default.
+// Built-in constraints from the package [github.com/muonsoft/validation/it] are registered
+// into it on the package initialization.
+func DefaultConstraintRegistry() *ConstraintRegistry {
+	return defaultConstraintRegistry
+}
+
+// RegisterConstraint registers the constraint factory in the [DefaultConstraintRegistry].
+func RegisterConstraint(name string, factory ConstraintFactory) {
+	defaultConstraintRegistry.Register(name, factory)
+}
+
+// Register registers the constraint factory under the given name.
+// If the name is already registered, the factory will be replaced.
+func (registry *ConstraintRegistry) Register(name string, factory ConstraintFactory) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	registry.factories[name] = factory
+}
+
+// Has returns true if the constraint is registered under the given name.
+func (registry *ConstraintRegistry) Has(name string) bool {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	_, ok := registry.factories[name]
+
+	return ok
+}
+
+// Names returns the sorted list of registered constraint names.
+func (registry *ConstraintRegistry) Names() []string {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	names := make([]string, 0, len(registry.factories))
+	for name := range registry.factories {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// Constraint creates a constraint registered under the given name for the value of the given type.
+// If the name is not registered, then [ConstraintNotFoundError] is returned.
+func (registry *ConstraintRegistry) Constraint(name string, valueType ValueType, options ConstraintOptions) (any, error) {
+	registry.mu.RLock()
+	factory, ok := registry.factories[name]
+	registry.mu.RUnlock()
+
+	if !ok {
+		return nil, &ConstraintNotFoundError{Key: name, Type: string(valueType)}
+	}
+
+	return factory(valueType, options)
+}
+
+// ConstraintOptions is a raw string of options passed to the [ConstraintFactory]. For example, for the
+// struct tag rule "length=1..255", the options are equal to "1..255". It has helper methods for typed parsing.
+type ConstraintOptions string
+
+// String returns options as a string.
+func (options ConstraintOptions) String() string {
+	return string(options)
+}
+
+// IsEmpty returns true if there are no options.
+func (options ConstraintOptions) IsEmpty() bool {
+	return strings.TrimSpace(string(options)) == ""
+}
+
+// Int parses options as an int value.
+func (options ConstraintOptions) Int() (int, error) {
+	return ParseConstraintOptions[int](options)
+}
+
+// Int64 parses options as an int64 value.
+func (options ConstraintOptions) Int64() (int64, error) {
+	return ParseConstraintOptions[int64](options)
+}
+
+// Uint64 parses options as an uint64 value.
+func (options ConstraintOptions) Uint64() (uint64, error) {
+	return ParseConstraintOptions[uint64](options)
+}
+
+// Float64 parses options as a float64 value.
+func (options ConstraintOptions) Float64() (float64, error) {
+	return ParseConstraintOptions[float64](options)
+}
+
+// Bool parses options as a bool value.
+func (options ConstraintOptions) Bool() (bool, error) {
+	return ParseConstraintOptions[bool](options)
+}
+
+// Range splits options in format "min..max" into two parts. If options do not contain
+// the range separator, then isRange is false and both parts are equal to the options.
+func (options ConstraintOptions) Range() (vMin, vMax ConstraintOptions, isRange bool) {
+	s, e, isRange := strings.Cut(string(options), "..")
+	if !isRange {
+		return options, options, false
+	}
+
+	return ConstraintOptions(s), ConstraintOptions(e), true
+}
+
+// List splits options in format "a|b|c" into a list. It returns nil for empty options.
+func (options ConstraintOptions) List() []ConstraintOptions {
+	if options == "" {
+		return nil
+	}
+
+	parts := strings.Split(string(options), "|")
+	list := make([]ConstraintOptions, len(parts))
+	for i, part := range parts {
+		list[i] = ConstraintOptions(part)
+	}
+
+	return list
+}
+
+// ConstraintOptionValue is a type constraint for values supported by [ParseConstraintOptions].
+type ConstraintOptionValue interface {
+	string | bool | int | int64 | uint64 | float64
+}
+
+// ParseConstraintOptions parses options into the value of the given type.
+func ParseConstraintOptions[T ConstraintOptionValue](options ConstraintOptions) (T, error) {
+	var value T
+	var err error
+	s := strings.TrimSpace(string(options))
+
+	switch v := any(&value).(type) {
+	case *string:
+		*v = string(options)
+	case *bool:
+		*v, err = strconv.ParseBool(s)
+	case *int:
+		*v, err = strconv.Atoi(s)
+	case *int64:
+		*v, err = strconv.ParseInt(s, 10, 64)
+	case *uint64:
+		*v, err = strconv.ParseUint(s, 10, 64)
+	case *float64:
+		*v, err = strconv.ParseFloat(s, 64)
+	}
+	if err != nil {
+		return value, fmt.Errorf(`parse options "%s" as %T: %w`, options, value, err)
+	}
+
+	return value, nil
+}
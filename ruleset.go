@@ -0,0 +1,214 @@
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// RuleSetSchema is a declarative description of validation rules. Keys are property paths
+// in the format supported by [PropertyPath.UnmarshalText] (for example, "author.email" or "tags[0]"),
+// values are lists of rules in the same format as in the struct tags (see [StructTag]) without
+// escaping: the constraint name with optional options separated by the equals sign.
+//
+// The schema can be decoded from JSON by [ParseRuleSetJSON] or from YAML by the separate
+// yamlvalidation module. For example:
+//
+//	{
+//	    "title": ["notblank", "length=1..255"],
+//	    "author.email": ["notblank", "email"],
+//	    "tags": ["count=1..10"]
+//	}
+type RuleSetSchema map[string][]string
+
+// RuleSet is a set of validation rules parsed from the [RuleSetSchema]. It can be used
+// to validate maps (for example, decoded from JSON) or structs by rules stored in configuration.
+// It is immutable and can be reused by multiple goroutines.
+type RuleSet struct {
+	rules []pathRules
+}
+
+type pathRules struct {
+	path  *PropertyPath
+	rules []structTagRule
+}
+
+// NewRuleSet parses the schema into the [RuleSet]. Property paths and rules are validated
+// on parsing, configuration errors are returned as [ConstraintError] with the offending path.
+// Constraint names are resolved from the [ConstraintRegistry] of the validator during validation.
+func NewRuleSet(schema RuleSetSchema) (*RuleSet, error) {
+	keys := make([]string, 0, len(schema))
+	for key := range schema {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	ruleSet := &RuleSet{rules: make([]pathRules, 0, len(schema))}
+	for _, key := range keys {
+		path := &PropertyPath{}
+		if err := path.UnmarshalText([]byte(key)); err != nil {
+			return nil, &ConstraintError{
+				ConstraintName: "RuleSet",
+				Description:    fmt.Sprintf(`invalid property path "%s": %s`, key, err.Error()),
+			}
+		}
+		if path.value == nil {
+			path = nil
+		}
+
+		rules := make([]structTagRule, 0, len(schema[key]))
+		for _, s := range schema[key] {
+			name, options, _ := strings.Cut(s, "=")
+			name = strings.TrimSpace(name)
+			if name == "" {
+				return nil, &ConstraintError{
+					ConstraintName: "RuleSet",
+					Path:           path,
+					Description:    fmt.Sprintf(`invalid rule "%s": empty constraint name`, s),
+				}
+			}
+			rules = append(rules, structTagRule{name: name, options: ConstraintOptions(options)})
+		}
+
+		ruleSet.rules = append(ruleSet.rules, pathRules{path: path, rules: rules})
+	}
+
+	return ruleSet, nil
+}
+
+// ParseRuleSetJSON parses the [RuleSetSchema] from JSON into the [RuleSet].
+func ParseRuleSetJSON(data []byte) (*RuleSet, error) {
+	var schema RuleSetSchema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("parse rule set: %w", err)
+	}
+
+	return NewRuleSet(schema)
+}
+
+// For returns the argument to validate the value by the rules. The value can be a map with string keys
+// (for example, map[string]any decoded from JSON), a struct, or a pointer to them. Struct fields are
+// resolved by the names from the json tags or by the field names (see [Struct]).
+//
+// Missing values and null values are treated as nil, so only the constraints checking for nil
+// (for example, "notblank" or "notnil") are applied to them. If the path cannot be resolved
+// in the value or the constraint cannot be applied to the value, then the validation process
+// will be terminated with [ConstraintError].
+func (ruleSet *RuleSet) For(value any) ValidatorArgument {
+	return NewArgument(func(ctx context.Context, validator *Validator) (*ViolationList, error) {
+		violations := NewViolationList()
+
+		for _, rules := range ruleSet.rules {
+			pathValidator := validator.At(rules.path.Elements()...)
+			v, err := resolvePathValue(reflect.ValueOf(value), rules.path)
+			if err != nil {
+				return nil, pathValidator.CreateConstraintError("RuleSet", err.Error())
+			}
+
+			for _, rule := range rules.rules {
+				err := violations.AppendFromError(applyRuleSetRule(ctx, pathValidator, rule, v))
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		return violations, nil
+	})
+}
+
+func applyRuleSetRule(ctx context.Context, validator *Validator, rule structTagRule, value reflect.Value) error {
+	v := newDynamicValue(value)
+	if !v.isNil || v.valueType != AnyType {
+		err := applyStructTagRule(ctx, validator, rule, value)
+		var notFound *ConstraintNotFoundError
+		if errors.As(err, &notFound) {
+			return validator.CreateConstraintError(rule.name, err.Error())
+		}
+		return err
+	}
+
+	// the type of the missing value is unknown, so only nil constraints are applied
+	constraint, err := validator.Constraint(rule.name, v.valueType, rule.options)
+	var notFound *ConstraintNotFoundError
+	if errors.As(err, &notFound) {
+		return validator.CreateConstraintError(rule.name, err.Error())
+	}
+	if c, ok := constraint.(NilConstraint); ok && err == nil {
+		return c.ValidateNil(ctx, validator, true)
+	}
+
+	return nil
+}
+
+var errPathNotResolved = errors.New("cannot resolve path")
+
+// resolvePathValue returns the value by the property path. It returns an invalid value
+// if the value is missing.
+func resolvePathValue(v reflect.Value, path *PropertyPath) (reflect.Value, error) {
+	for _, element := range path.All() {
+		for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+			if v.IsNil() {
+				return reflect.Value{}, nil
+			}
+			v = v.Elem()
+		}
+		if !v.IsValid() {
+			return v, nil
+		}
+
+		var err error
+		if index, ok := element.(ArrayIndex); ok {
+			v, err = resolveIndexValue(v, int(index))
+		} else {
+			v, err = resolvePropertyValue(v, element.String())
+		}
+		if err != nil {
+			return reflect.Value{}, err
+		}
+	}
+
+	return v, nil
+}
+
+func resolveIndexValue(v reflect.Value, index int) (reflect.Value, error) {
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return reflect.Value{}, fmt.Errorf(
+			`%w: index %d of value of type "%s"`, errPathNotResolved, index, v.Type().String(),
+		)
+	}
+	if index >= v.Len() {
+		return reflect.Value{}, nil
+	}
+
+	return v.Index(index), nil
+}
+
+func resolvePropertyValue(v reflect.Value, name string) (reflect.Value, error) {
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Type().Key().Kind() == reflect.String {
+			return v.MapIndex(reflect.ValueOf(name).Convert(v.Type().Key())), nil
+		}
+	case reflect.Struct:
+		for _, field := range getStructFields(v.Type()) {
+			if field.name == name {
+				value, err := v.FieldByIndexErr(field.index)
+				if err != nil {
+					// nil pointer to the embedded struct
+					return reflect.Value{}, nil
+				}
+				return value, nil
+			}
+		}
+	default:
+	}
+
+	return reflect.Value{}, fmt.Errorf(
+		`%w: property "%s" of value of type "%s"`, errPathNotResolved, name, v.Type().String(),
+	)
+}
@@ -0,0 +1,143 @@
+package validation
+
+import (
+	"context"
+	"sync"
+
+	"github.com/muonsoft/language"
+)
+
+// validationRun holds the state shared by all validators of the single call of the [Validator.Validate]
+// method, including the validators of the nested validation. It is passed to the nested calls via the context.
+type validationRun struct {
+	// limit is the maximum number of violations of the run, nil if there is no limit
+	limit *violationLimit
+	// parent is the run that holds the cache and the memo when the nested run is started to apply the limit
+	parent *validationRun
+
+	mu     sync.Mutex
+	caches map[*asyncLoaderKey]any
+	memo   map[any]*memoEntry
+}
+
+type validationRunKey struct{}
+
+// startRun returns the validator bound to the current validation run. If the run is not started yet,
+// then the new run is started and passed via the context to the nested validators. The nested run
+// is also started if the validator has the limit of violations and the current run has no limit.
+// The returned flag is true if the run is started by this call.
+func (validator *Validator) startRun(ctx context.Context) (*Validator, context.Context, bool) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	run, _ := ctx.Value(validationRunKey{}).(*validationRun)
+	isStarted := false
+	if run == nil || run.limit == nil && validator.maxViolations > 0 {
+		run = &validationRun{parent: run}
+		if validator.maxViolations > 0 {
+			run.limit = &violationLimit{max: validator.maxViolations}
+		}
+		ctx = context.WithValue(ctx, validationRunKey{}, run)
+		isStarted = true
+	}
+	if validator.run == run {
+		return validator, ctx, isStarted
+	}
+
+	v := validator.copy()
+	v.run = run
+	v.violationFactory = newRunViolationFactory(ctx, validator.violationFactory, run.limit, validator.observer)
+
+	return v, ctx, isStarted
+}
+
+// runViolationFactory counts the violations created during the validation run
+// and notifies the observer about them.
+type runViolationFactory struct {
+	factory  ViolationFactory
+	limit    *violationLimit
+	observer Observer
+	// ctx is the context of the validation run passed to the observer
+	ctx context.Context //nolint:containedctx // the factory lives only during the validation run
+}
+
+func newRunViolationFactory(
+	ctx context.Context,
+	factory ViolationFactory,
+	limit *violationLimit,
+	observer Observer,
+) ViolationFactory {
+	if f, ok := factory.(*runViolationFactory); ok {
+		factory = f.factory
+	}
+	if limit == nil && observer == nil {
+		return factory
+	}
+
+	return &runViolationFactory{factory: factory, limit: limit, observer: observer, ctx: ctx}
+}
+
+func (f *runViolationFactory) CreateViolation(
+	err error,
+	messageTemplate string,
+	pluralCount int,
+	parameters []TemplateParameter,
+	propertyPath *PropertyPath,
+	lang language.Tag,
+) Violation {
+	return f.CreateDetailedViolation(err, messageTemplate, pluralCount, parameters, propertyPath, lang, ViolationDetails{})
+}
+
+func (f *runViolationFactory) CreateDetailedViolation(
+	err error,
+	messageTemplate string,
+	pluralCount int,
+	parameters []TemplateParameter,
+	propertyPath *PropertyPath,
+	lang language.Tag,
+	details ViolationDetails,
+) Violation {
+	if f.limit != nil {
+		f.limit.count.Add(1)
+	}
+	violation := createViolation(f.factory, err, messageTemplate, pluralCount, parameters, propertyPath, lang, details)
+	if f.observer != nil {
+		f.observer.ViolationCreated(f.ctx, violation)
+	}
+
+	return violation
+}
+
+// finishRun applies the limit of violations to the result of the run.
+func (validator *Validator) finishRun(violations *ViolationList) {
+	if validator.run.limit != nil {
+		validator.run.limit.apply(violations)
+	}
+}
+
+// cache returns the cache of the run created by the function for the given key.
+func (run *validationRun) cache(key *asyncLoaderKey, create func() any) any {
+	run = run.root()
+	run.mu.Lock()
+	defer run.mu.Unlock()
+
+	if run.caches == nil {
+		run.caches = make(map[*asyncLoaderKey]any)
+	}
+	cache, ok := run.caches[key]
+	if !ok {
+		cache = create()
+		run.caches[key] = cache
+	}
+
+	return cache
+}
+
+// root returns the run holding the shared state of the nested runs.
+func (run *validationRun) root() *validationRun {
+	for run.parent != nil {
+		run = run.parent
+	}
+
+	return run
+}
@@ -0,0 +1,71 @@
+package validation
+
+import (
+	"fmt"
+)
+
+// Severity is the level of the violation. Only violations with the [SeverityError] level are blocking:
+// they fail the validation. Violations with the [SeverityWarning] and [SeverityNotice] levels are reported,
+// but the validation is considered successful (see [ViolationList.AsError] and [Validator.ValidateWithWarnings]).
+// Use the WithSeverity method of the constraint or the [ViolationBuilder] to set up the level.
+type Severity byte
+
+const (
+	// SeverityError is the default level of the violation. The violation fails the validation.
+	SeverityError Severity = iota
+	// SeverityWarning is the level of the violation that is reported but does not fail the validation,
+	// for example, "the password is weak".
+	SeverityWarning
+	// SeverityNotice is the level of the informational violation that does not fail the validation.
+	SeverityNotice
+)
+
+// String returns the name of the level: "error", "warning", or "notice".
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	case SeverityNotice:
+		return "notice"
+	}
+
+	return fmt.Sprintf("Severity(%d)", s)
+}
+
+// IsBlocking returns true if the violation of this level fails the validation.
+func (s Severity) IsBlocking() bool {
+	return s == SeverityError
+}
+
+// MarshalText encodes the level as its name.
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes the level from its name. An empty string is decoded as [SeverityError].
+func (s *Severity) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "", "error":
+		*s = SeverityError
+	case "warning":
+		*s = SeverityWarning
+	case "notice":
+		*s = SeverityNotice
+	default:
+		return fmt.Errorf(`%w: "%s"`, errUnknownSeverity, string(text))
+	}
+
+	return nil
+}
+
+// SeverityOf returns the level of the violation. If the violation does not provide the level
+// (does not implement the Severity method), then it is considered as [SeverityError].
+func SeverityOf(violation Violation) Severity {
+	if v, ok := violation.(interface{ Severity() Severity }); ok {
+		return v.Severity()
+	}
+
+	return SeverityError
+}
@@ -0,0 +1,394 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// StructTag is the name of the struct tag that is used by the [Struct] argument to read validation rules.
+//
+// Rules are separated by commas. Each rule consists of the constraint name and optional options
+// separated by the equals sign. Use a backslash to escape the comma in options. For example:
+//
+//	type Book struct {
+//	    Title  string   `json:"title" validate:"notblank,length=1..255"`
+//	    Email  string   `json:"email" validate:"email"`
+//	    Tags   []string `json:"tags" validate:"count=1..10"`
+//	    Author Author   `json:"author"`
+//	}
+//
+// Use "-" as the tag value to skip a field (including validation of nested values).
+const StructTag = "validate"
+
+// Struct argument is used to validate a struct by rules defined in the struct tags (see [StructTag]).
+// Constraints are resolved by names from the [ConstraintRegistry] of the validator (see [SetConstraintRegistry]).
+// Built-in constraints are registered by the package [github.com/muonsoft/validation/it].
+//
+// The property path is built from the names of the fields. If a field has a json tag, then the name
+// from the tag is used. Nested structs, pointers to structs, slices, arrays, and maps of structs are
+// validated recursively. If a nested value implements the [Validatable] interface, then its Validate
+// method is used instead of the struct tags. Unexported fields are ignored and embedded structs without
+// json names are treated as parts of the parent struct.
+//
+// Rules are applied to the field value according to its [ValueType]: signed integers are validated
+// as int64, unsigned integers as uint64, floats as float64, and the length is validated for arrays,
+// slices, and maps. If the constraint is not registered, then the validation process will be terminated
+// with [ConstraintNotFoundError]. If the constraint cannot be applied to the field type,
+// then the validation process will be terminated with [ConstraintError].
+func Struct(value any) ValidatorArgument {
+	return NewArgument(validateStruct(value))
+}
+
+// StructProperty argument is an alias for [Struct] that automatically adds property name to the current validation context.
+func StructProperty(name string, value any) ValidatorArgument {
+	return NewArgument(validateStruct(value)).At(PropertyName(name))
+}
+
+type structField struct {
+	index  []int
+	name   string
+	rules  []structTagRule
+	isDive bool
+	tagErr string
+}
+
+type structTagRule struct {
+	name    string
+	options ConstraintOptions
+}
+
+var structFieldsCache sync.Map
+
+func validateStruct(value any) ValidateFunc {
+	return func(ctx context.Context, validator *Validator) (*ViolationList, error) {
+		v := reflect.ValueOf(value)
+		for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+			if v.IsNil() {
+				return nil, nil
+			}
+			v = v.Elem()
+		}
+		if !v.IsValid() {
+			return nil, nil
+		}
+		if v.Kind() != reflect.Struct {
+			return nil, validator.CreateConstraintError(
+				"Struct",
+				fmt.Sprintf(`value of type "%s" is not a struct`, v.Type().String()),
+			)
+		}
+
+		return validateStructFields(ctx, validator, v)
+	}
+}
+
+func validateStructFields(ctx context.Context, validator *Validator, v reflect.Value) (*ViolationList, error) {
+	violations := NewViolationList()
+
+	for _, field := range getStructFields(v.Type()) {
+		if validator.isLimitReached() {
+			break
+		}
+		value, err := v.FieldByIndexErr(field.index)
+		if err != nil {
+			// nil pointer to the embedded struct
+			continue
+		}
+
+		fieldValidator := validator.AtProperty(field.name)
+		if field.tagErr != "" {
+			return nil, fieldValidator.CreateConstraintError("Struct", field.tagErr)
+		}
+
+		for _, rule := range field.rules {
+			err := violations.AppendFromError(applyStructTagRule(ctx, fieldValidator, rule, value))
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if field.isDive {
+			vs, err := validateNestedValue(ctx, fieldValidator, value)
+			if err != nil {
+				return nil, err
+			}
+			violations.Join(vs)
+		}
+	}
+
+	return violations, nil
+}
+
+func applyStructTagRule(ctx context.Context, validator *Validator, rule structTagRule, value reflect.Value) error {
+	v := newDynamicValue(value)
+	constraint, err := validator.Constraint(rule.name, v.valueType, rule.options)
+	var notFound *ConstraintNotFoundError
+	if errors.As(err, &notFound) {
+		return err
+	}
+	if err != nil {
+		return validator.CreateConstraintError(rule.name, err.Error())
+	}
+
+	err = validator.evaluate(ctx, constraint, func() error {
+		return applyConstraint(ctx, validator, constraint, v)
+	})
+	if errors.Is(err, errConstraintNotApplicable) {
+		return validator.CreateConstraintError(
+			rule.name,
+			fmt.Sprintf(`constraint of type "%T" is not applicable to value of type "%s"`, constraint, v.valueType),
+		)
+	}
+
+	return err
+}
+
+func validateNestedValue(ctx context.Context, validator *Validator, v reflect.Value) (*ViolationList, error) {
+	if validator.isLimitReached() {
+		return nil, nil
+	}
+	for {
+		if (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) && v.IsNil() {
+			return nil, nil
+		}
+		if validatable, ok := asValidatable(v); ok {
+			return validateIt(validatable)(ctx, validator)
+		}
+		if v.Kind() != reflect.Pointer && v.Kind() != reflect.Interface {
+			break
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		if v.Type() == timeReflectType {
+			return nil, nil
+		}
+		return validateStructFields(ctx, validator, v)
+	case reflect.Slice, reflect.Array:
+		return validateNestedSlice(ctx, validator, v)
+	case reflect.Map:
+		return validateNestedMap(ctx, validator, v)
+	default:
+		return nil, nil
+	}
+}
+
+func validateNestedSlice(ctx context.Context, validator *Validator, v reflect.Value) (*ViolationList, error) {
+	if !isDiveType(v.Type().Elem()) {
+		return nil, nil
+	}
+
+	violations := NewViolationList()
+	for i := 0; i < v.Len(); i++ {
+		vs, err := validateNestedValue(ctx, validator.AtIndex(i), v.Index(i))
+		if err != nil {
+			return nil, err
+		}
+		violations.Join(vs)
+	}
+
+	return violations, nil
+}
+
+func validateNestedMap(ctx context.Context, validator *Validator, v reflect.Value) (*ViolationList, error) {
+	if !isDiveType(v.Type().Elem()) {
+		return nil, nil
+	}
+
+	type mapItem struct {
+		key  reflect.Value
+		name string
+	}
+	items := make([]mapItem, 0, v.Len())
+	for _, key := range v.MapKeys() {
+		item := mapItem{key: key}
+		if key.Kind() == reflect.String {
+			item.name = key.String()
+		} else {
+			item.name = fmt.Sprint(key.Interface())
+		}
+		items = append(items, item)
+	}
+	slices.SortFunc(items, func(a, b mapItem) int {
+		return strings.Compare(a.name, b.name)
+	})
+
+	violations := NewViolationList()
+	for _, item := range items {
+		vs, err := validateNestedValue(ctx, validator.AtProperty(item.name), v.MapIndex(item.key))
+		if err != nil {
+			return nil, err
+		}
+		violations.Join(vs)
+	}
+
+	return violations, nil
+}
+
+func asValidatable(v reflect.Value) (Validatable, bool) {
+	if !v.IsValid() || !v.CanInterface() {
+		return nil, false
+	}
+	if v.Type().Implements(validatableReflectType) {
+		validatable, ok := v.Interface().(Validatable)
+		return validatable, ok
+	}
+	if v.CanAddr() && reflect.PointerTo(v.Type()).Implements(validatableReflectType) {
+		validatable, ok := v.Addr().Interface().(Validatable)
+		return validatable, ok
+	}
+
+	return nil, false
+}
+
+func getStructFields(t reflect.Type) []structField {
+	if fields, ok := structFieldsCache.Load(t); ok {
+		return fields.([]structField) //nolint:forcetypeassert // cache contains only this type
+	}
+
+	fields := parseStructFields(t, nil)
+	structFieldsCache.Store(t, fields)
+
+	return fields
+}
+
+func parseStructFields(t reflect.Type, index []int) []structField {
+	fields := make([]structField, 0, t.NumField())
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, hasTag := f.Tag.Lookup(StructTag)
+		if tag == "-" {
+			continue
+		}
+		fieldIndex := append(slices.Clone(index), i)
+		name, hasName := getJSONName(f)
+
+		if f.Anonymous && !hasName && !hasTag {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct && ft != timeReflectType {
+				fields = append(fields, parseStructFields(ft, fieldIndex)...)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+
+		field := structField{
+			index:  fieldIndex,
+			name:   name,
+			isDive: isDiveType(f.Type),
+		}
+		field.rules, field.tagErr = parseStructTag(tag)
+		fields = append(fields, field)
+	}
+
+	return fields
+}
+
+func getJSONName(f reflect.StructField) (string, bool) {
+	tag, ok := f.Tag.Lookup("json")
+	if !ok {
+		return f.Name, false
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "" || name == "-" {
+		return f.Name, false
+	}
+
+	return name, true
+}
+
+func parseStructTag(tag string) ([]structTagRule, string) {
+	if tag == "" {
+		return nil, ""
+	}
+
+	var rules []structTagRule
+	for _, s := range splitStructTag(tag) {
+		name, options, _ := strings.Cut(s, "=")
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return nil, fmt.Sprintf(`invalid tag "%s": empty constraint name`, tag)
+		}
+		rules = append(rules, structTagRule{name: name, options: ConstraintOptions(options)})
+	}
+
+	return rules, ""
+}
+
+func splitStructTag(tag string) []string {
+	var parts []string
+	var s strings.Builder
+	isEscape := false
+
+	for _, c := range tag {
+		switch {
+		case isEscape:
+			if c != ',' && c != '\\' {
+				s.WriteRune('\\')
+			}
+			s.WriteRune(c)
+			isEscape = false
+		case c == '\\':
+			isEscape = true
+		case c == ',':
+			parts = append(parts, s.String())
+			s.Reset()
+		default:
+			s.WriteRune(c)
+		}
+	}
+	if isEscape {
+		s.WriteRune('\\')
+	}
+
+	return append(parts, s.String())
+}
+
+var diveTypesCache sync.Map
+
+// isDiveType returns true if the value of the given type may contain nested values that should be validated.
+func isDiveType(t reflect.Type) bool {
+	if is, ok := diveTypesCache.Load(t); ok {
+		return is.(bool) //nolint:forcetypeassert // cache contains only this type
+	}
+
+	is := checkDiveType(t, map[reflect.Type]bool{})
+	diveTypesCache.Store(t, is)
+
+	return is
+}
+
+func checkDiveType(t reflect.Type, visited map[reflect.Type]bool) bool {
+	if visited[t] {
+		return false
+	}
+	visited[t] = true
+
+	if t.Implements(validatableReflectType) || reflect.PointerTo(t).Implements(validatableReflectType) {
+		return true
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		return t != timeReflectType
+	case reflect.Interface:
+		return true
+	case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+		return checkDiveType(t.Elem(), visited)
+	default:
+		return false
+	}
+}
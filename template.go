@@ -1,6 +1,9 @@
 package validation
 
-import "strings"
+import (
+	"slices"
+	"strings"
+)
 
 // TemplateParameter is injected into the message while rendering the template.
 type TemplateParameter struct {
@@ -14,6 +17,11 @@ type TemplateParameter struct {
 
 	// NeedsTranslation marks that the template value needs to be translated.
 	NeedsTranslation bool
+
+	// Sensitive marks that the template value contains a secret (for example, a password or a card number).
+	// The value is masked by [RedactedValue] in the message and in the parameters of the violations
+	// created by [BuiltinViolationFactory]. The original value is available only by [UnsafeParameters].
+	Sensitive bool
 }
 
 // TemplateParameterList is a list of template parameters that can be injection into violation message.
@@ -24,6 +32,38 @@ func (params TemplateParameterList) Prepend(parameters ...TemplateParameter) Tem
 	return append(parameters, params...)
 }
 
+// sensitiveParameters returns a copy of the parameters marked as sensitive.
+func sensitiveParameters(parameters []TemplateParameter) []TemplateParameter {
+	if len(parameters) == 0 {
+		return parameters
+	}
+
+	sensitive := make([]TemplateParameter, len(parameters))
+	for i, parameter := range parameters {
+		parameter.Sensitive = true
+		sensitive[i] = parameter
+	}
+
+	return sensitive
+}
+
+// maskParameters returns a copy of the parameters with masked values of sensitive parameters.
+// If there are no sensitive parameters, then the parameters are returned as is and the flag is false.
+func maskParameters(parameters []TemplateParameter) ([]TemplateParameter, bool) {
+	if !slices.ContainsFunc(parameters, func(p TemplateParameter) bool { return p.Sensitive }) {
+		return parameters, false
+	}
+
+	masked := slices.Clone(parameters)
+	for i := range masked {
+		if masked[i].Sensitive {
+			masked[i].Value = RedactedValue
+		}
+	}
+
+	return masked, true
+}
+
 func renderMessage(template string, parameters []TemplateParameter) string {
 	message := template
 
@@ -0,0 +1,217 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/validationtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	errEmailTaken     = validation.NewError("test: email is taken", "Email {{ value }} is already registered.")
+	errStorageFailure = errors.New("storage failure")
+)
+
+// inMemoryEmailLoader checks that emails are not registered yet.
+type inMemoryEmailLoader struct {
+	mu         sync.Mutex
+	registered map[string]bool
+	calls      [][]string
+	err        error
+}
+
+func newInMemoryEmailLoader(registered ...string) *inMemoryEmailLoader {
+	loader := &inMemoryEmailLoader{registered: make(map[string]bool)}
+	for _, email := range registered {
+		loader.registered[email] = true
+	}
+	return loader
+}
+
+func (loader *inMemoryEmailLoader) Load(ctx context.Context, emails []string) (map[string]bool, error) {
+	loader.mu.Lock()
+	defer loader.mu.Unlock()
+
+	loader.calls = append(loader.calls, emails)
+	if loader.err != nil {
+		return nil, loader.err
+	}
+	results := make(map[string]bool, len(emails))
+	for _, email := range emails {
+		results[email] = !loader.registered[email]
+	}
+	return results, nil
+}
+
+func (loader *inMemoryEmailLoader) Calls() [][]string {
+	loader.mu.Lock()
+	defer loader.mu.Unlock()
+	return loader.calls
+}
+
+func newUniqueEmailConstraint(loader validation.AsyncLoader[string]) validation.AsyncConstraint[string] {
+	return validation.NewAsyncConstraint(loader).WithError(errEmailTaken).WithMessage(errEmailTaken.Message())
+}
+
+type mockUser struct {
+	email      string
+	constraint validation.AsyncConstraint[string]
+}
+
+func (user mockUser) Validate(ctx context.Context, validator *validation.Validator) error {
+	return validator.Validate(ctx, validation.ComparableProperty("email", user.email, user.constraint))
+}
+
+func TestAsyncConstraint_WhenEach_ExpectValuesLoadedByOneCall(t *testing.T) {
+	loader := newInMemoryEmailLoader("taken@example.com")
+	constraint := newUniqueEmailConstraint(loader)
+
+	err := newValidator(t).Validate(context.Background(), validation.EachProperty(
+		"emails",
+		[]string{"free@example.com", "taken@example.com", "", "taken@example.com"},
+		constraint,
+	))
+
+	validationtest.Assert(t, err).IsViolations(
+		validationtest.ViolationAttributes{
+			Error:        errEmailTaken,
+			Message:      `Email "taken@example.com" is already registered.`,
+			PropertyPath: "emails[1]",
+		},
+		validationtest.ViolationAttributes{
+			Error:        errEmailTaken,
+			Message:      `Email "taken@example.com" is already registered.`,
+			PropertyPath: "emails[3]",
+		},
+	)
+	assert.Equal(t, [][]string{{"free@example.com", "taken@example.com"}}, loader.Calls())
+}
+
+func TestAsyncConstraint_WhenEachParallel_ExpectValuesLoadedByOneCall(t *testing.T) {
+	loader := newInMemoryEmailLoader("b@example.com")
+	constraint := newUniqueEmailConstraint(loader)
+
+	err := newValidator(t).Validate(context.Background(), validation.EachParallel(
+		[]string{"a@example.com", "b@example.com", "c@example.com"},
+		2,
+		constraint,
+	))
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(errEmailTaken).
+		WithPropertyPath("[1]")
+	assert.Len(t, loader.Calls(), 1)
+}
+
+func TestAsyncConstraint_WhenSlice_ExpectViolationsAtIndexes(t *testing.T) {
+	loader := newInMemoryEmailLoader("a@example.com", "c@example.com")
+	constraint := newUniqueEmailConstraint(loader)
+
+	err := newValidator(t).Validate(context.Background(), validation.SliceProperty(
+		"emails",
+		[]string{"a@example.com", "b@example.com", "c@example.com"},
+		constraint,
+	))
+
+	validationtest.Assert(t, err).IsViolations(
+		validationtest.ViolationAttributes{Error: errEmailTaken, PropertyPath: "emails[0]"},
+		validationtest.ViolationAttributes{Error: errEmailTaken, PropertyPath: "emails[2]"},
+	)
+	assert.Len(t, loader.Calls(), 1)
+}
+
+func TestAsyncConstraint_WhenSameValueInDifferentPlaces_ExpectCachedWithinRun(t *testing.T) {
+	loader := newInMemoryEmailLoader("taken@example.com")
+	constraint := newUniqueEmailConstraint(loader)
+	users := []mockUser{
+		{email: "taken@example.com", constraint: constraint},
+		{email: "free@example.com", constraint: constraint},
+		{email: "taken@example.com", constraint: constraint},
+	}
+
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.ComparableProperty("email", "taken@example.com", constraint),
+		validation.ValidSliceProperty("users", users),
+	)
+
+	validationtest.Assert(t, err).IsViolations(
+		validationtest.ViolationAttributes{Error: errEmailTaken, PropertyPath: "email"},
+		validationtest.ViolationAttributes{Error: errEmailTaken, PropertyPath: "users[0].email"},
+		validationtest.ViolationAttributes{Error: errEmailTaken, PropertyPath: "users[2].email"},
+	)
+	assert.Equal(t, [][]string{{"taken@example.com"}, {"free@example.com"}}, loader.Calls())
+}
+
+func TestAsyncConstraint_WhenDifferentRuns_ExpectValuesLoadedAgain(t *testing.T) {
+	loader := newInMemoryEmailLoader()
+	constraint := newUniqueEmailConstraint(loader)
+	validator := newValidator(t)
+
+	for range 2 {
+		err := validator.Validate(context.Background(), validation.This("free@example.com", constraint))
+		require.NoError(t, err)
+	}
+
+	assert.Len(t, loader.Calls(), 2)
+}
+
+func TestAsyncConstraint_WhenComparable_ExpectValueChecked(t *testing.T) {
+	loader := newInMemoryEmailLoader("taken@example.com")
+
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.ComparableProperty("email", "taken@example.com", newUniqueEmailConstraint(loader)),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(errEmailTaken).
+		WithPropertyPath("email")
+}
+
+func TestAsyncConstraint_WhenTimeoutExceeded_ExpectDeadlineError(t *testing.T) {
+	loader := validation.AsyncLoaderFunc[string](func(ctx context.Context, values []string) (map[string]bool, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.This("email@example.com", validation.NewAsyncConstraint[string](loader).WithTimeout(time.Millisecond)),
+	)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.False(t, validation.IsViolationList(err))
+}
+
+func TestAsyncConstraint_WhenLoaderFails_ExpectError(t *testing.T) {
+	loader := newInMemoryEmailLoader()
+	loader.err = errStorageFailure
+
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.Each([]string{"a@example.com"}, newUniqueEmailConstraint(loader)),
+	)
+
+	assert.ErrorIs(t, err, errStorageFailure)
+	assert.ErrorContains(t, err, "load values of async constraint")
+}
+
+func TestAsyncConstraint_WhenIgnored_ExpectLoaderNotCalled(t *testing.T) {
+	loader := newInMemoryEmailLoader("taken@example.com")
+
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.Each([]string{"taken@example.com"}, newUniqueEmailConstraint(loader).When(false)),
+		validation.This("taken@example.com", newUniqueEmailConstraint(loader).WhenGroups("registration")),
+	)
+
+	assert.NoError(t, err)
+	assert.Empty(t, loader.Calls())
+}
@@ -2,6 +2,7 @@ package test
 
 import (
 	"context"
+	"strconv"
 	"testing"
 
 	"github.com/muonsoft/validation"
@@ -62,3 +63,74 @@ func makeProperties(n int) Properties {
 	}
 	return properties
 }
+
+// batchSize is the size of the batch import used to compare sequential and parallel validation.
+const batchSize = 100_000
+
+func BenchmarkEach(b *testing.B) {
+	emails := makeEmails(batchSize)
+	validator := newValidator(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		validator.Validate(context.Background(), validation.Each(emails, it.IsEmail()))
+	}
+}
+
+func BenchmarkEachParallel(b *testing.B) {
+	emails := makeEmails(batchSize)
+	validator := newValidator(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		validator.Validate(context.Background(), validation.EachParallel(emails, 0, it.IsEmail()))
+	}
+}
+
+func BenchmarkValidSlice(b *testing.B) {
+	properties := makeBatchProperties(batchSize)
+	validator := newValidator(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		validator.Validate(context.Background(), validation.ValidSlice(properties))
+	}
+}
+
+func BenchmarkValidSliceParallel(b *testing.B) {
+	properties := makeBatchProperties(batchSize)
+	validator := newValidator(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		validator.Validate(context.Background(), validation.ValidSliceParallel(properties, 0))
+	}
+}
+
+// makeBatchProperties returns a flat list of properties with every tenth property being invalid.
+func makeBatchProperties(n int) Properties {
+	properties := make(Properties, n)
+	for i := range properties {
+		if i%10 != 0 {
+			properties[i].Name = "property-" + strconv.Itoa(i)
+		}
+	}
+	return properties
+}
+
+// makeEmails returns a list of emails with every tenth email being invalid.
+func makeEmails(n int) []string {
+	emails := make([]string, n)
+	for i := range emails {
+		if i%10 == 0 {
+			emails[i] = "invalid-" + strconv.Itoa(i)
+		} else {
+			emails[i] = "user-" + strconv.Itoa(i) + "@example.com"
+		}
+	}
+	return emails
+}
@@ -0,0 +1,142 @@
+package test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/is"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/message"
+	"github.com/muonsoft/validation/validate"
+	"github.com/muonsoft/validation/validationtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateComparable_WhenIPAddr_ExpectIPValidated(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      netip.Addr
+		constraint it.IPConstraint
+		assert     func(t *testing.T, err error)
+	}{
+		{
+			name:       "zero value",
+			constraint: it.IsIP().DenyPrivateIP(),
+			assert:     assertNoError,
+		},
+		{
+			name:       "public IP",
+			value:      netip.MustParseAddr("8.8.8.8"),
+			constraint: it.IsIP().DenyPrivateIP().DenyReserved(),
+			assert:     assertNoError,
+		},
+		{
+			name:       "private IP",
+			value:      netip.MustParseAddr("10.0.0.1"),
+			constraint: it.IsIP().DenyPrivateIP(),
+			assert:     assertHasOneViolation(validation.ErrProhibitedIP, message.ProhibitedIP),
+		},
+		{
+			name:       "IPv6 as IPv4",
+			value:      netip.MustParseAddr("::1"),
+			constraint: it.IsIPv4(),
+			assert:     assertHasOneViolation(validation.ErrInvalidIP, message.InvalidIP),
+		},
+		{
+			name:       "IP with zone",
+			value:      netip.MustParseAddr("fe80::1%eth0"),
+			constraint: it.IsIP(),
+			assert:     assertHasOneViolation(validation.ErrInvalidIP, message.InvalidIP),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := newValidator(t).Validate(context.Background(), validation.Comparable(test.value, test.constraint))
+
+			test.assert(t, err)
+		})
+	}
+}
+
+func TestValidateComparable_WhenIPAddr_ExpectValueParameter(t *testing.T) {
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.ComparableProperty("ip", netip.MustParseAddr("::ffff:127.0.0.1"), it.IsIP().DenyLoopback()),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(validation.ErrProhibitedIP).
+		WithPropertyPath("ip")
+	violation := unwrapViolations(t, err).First()
+	assert.Equal(t, "::ffff:127.0.0.1", violation.Parameters()[0].Value)
+}
+
+func TestIPZone_WhenIsAndItPackages_ExpectSameDefault(t *testing.T) {
+	tests := []struct {
+		name       string
+		isValid    func(value string) bool
+		constraint it.IPConstraint
+	}{
+		{name: "IP", isValid: func(value string) bool { return is.IP(value) }, constraint: it.IsIP()},
+		{name: "IPv6", isValid: func(value string) bool { return is.IPv6(value) }, constraint: it.IsIPv6()},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := newValidator(t).Validate(context.Background(), validation.String("fe80::1%eth0", test.constraint))
+
+			assert.False(t, test.isValid("fe80::1%eth0"))
+			validationtest.Assert(t, err).IsViolationList().WithOneViolation().WithError(validation.ErrInvalidIP)
+		})
+	}
+}
+
+func TestIPZone_WhenZoneAllowed_ExpectSameResult(t *testing.T) {
+	err := newValidator(t).Validate(context.Background(), validation.String("fe80::1%eth0", it.IsIPv6().AllowZone()))
+
+	assert.NoError(t, validate.IPAddrWithZone(netip.MustParseAddr("fe80::1%eth0")))
+	assert.NoError(t, err)
+}
+
+func TestValidateSlice_WhenOverlappingCIDRs_ExpectViolationsAtIndexes(t *testing.T) {
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.SliceProperty("subnets", []string{
+			"10.0.0.0/24",
+			"10.0.1.0/24",
+			"invalid",
+			"10.0.0.128/25",
+			"2001:db8::/32",
+		}, it.HasNoOverlappingCIDRs()),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithLen(2)
+	validationtest.Assert(t, err).IsViolationList().HasViolationAt(0).
+		WithError(validation.ErrCIDROverlap).
+		WithMessage("This network overlaps with the network 10.0.0.128/25.").
+		WithPropertyPath("subnets[0]")
+	validationtest.Assert(t, err).IsViolationList().HasViolationAt(1).
+		WithError(validation.ErrCIDROverlap).
+		WithMessage("This network overlaps with the network 10.0.0.0/24.").
+		WithPropertyPath("subnets[3]")
+}
+
+func TestValidateSlice_WhenNoOverlappingCIDRs_ExpectNoError(t *testing.T) {
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.Slice([]string{"10.0.0.0/24", "10.0.1.0/24", "10.0.2.0/23"}, it.HasNoOverlappingCIDRs()),
+	)
+
+	assert.NoError(t, err)
+}
+
+func TestValidateSlice_WhenOverlappingCIDRsWithWarning_ExpectNoError(t *testing.T) {
+	warnings, err := newValidator(t).ValidateWithWarnings(
+		context.Background(),
+		validation.Slice([]string{"10.0.0.0/8", "10.1.0.0/16"}, it.HasNoOverlappingCIDRs().WithSeverity(validation.SeverityWarning)),
+	)
+
+	assert.NoError(t, err)
+	validationtest.Assert(t, warnings).IsViolationList().WithLen(2)
+}
@@ -0,0 +1,205 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/validationtest"
+	"github.com/stretchr/testify/assert"
+)
+
+const orderJSONSchema = `{
+	"type": "object",
+	"properties": {
+		"id": {"type": "string", "format": "uuid"},
+		"email": {"type": "string", "format": "email"},
+		"status": {"enum": ["new", "paid"]},
+		"comment": {"type": "string", "minLength": 3, "maxLength": 10},
+		"items": {
+			"type": "array",
+			"minItems": 1,
+			"items": {
+				"type": "object",
+				"properties": {
+					"name": {"type": "string", "pattern": "^[a-z]+$"},
+					"price": {"type": "number", "exclusiveMinimum": 0, "maximum": 1000},
+					"quantity": {"type": "integer", "minimum": 1, "multipleOf": 2}
+				},
+				"required": ["name", "price"],
+				"additionalProperties": false
+			}
+		},
+		"tags": {"type": "array", "uniqueItems": true, "maxItems": 2}
+	},
+	"required": ["id", "items"]
+}`
+
+func TestMatchesJSONSchema_WhenValidDocument_ExpectNoError(t *testing.T) {
+	document := `{"id": "83eab6fd-230b-44fe-b52f-463387bd8788", "items": [{"name": "book", "price": 10.5}]}`
+
+	err := newValidator(t).Validate(context.Background(), validation.String(document, it.MatchesJSONSchema(orderJSONSchema)))
+
+	assertNoError(t, err)
+}
+
+func TestMatchesJSONSchema_WhenEmptyString_ExpectNoError(t *testing.T) {
+	err := newValidator(t).Validate(context.Background(), validation.String("", it.MatchesJSONSchema(orderJSONSchema)))
+
+	assertNoError(t, err)
+}
+
+func TestMatchesJSONSchema_WhenInvalidJSON_ExpectViolation(t *testing.T) {
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.StringProperty("document", "{", it.MatchesJSONSchema(orderJSONSchema)),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(validation.ErrInvalidJSON).
+		WithPropertyPath("document")
+}
+
+func TestMatchesJSONSchema_WhenInvalidDocument_ExpectViolationsAtJSONPointers(t *testing.T) {
+	document := `{
+		"id": "invalid",
+		"email": "invalid",
+		"status": "unknown",
+		"comment": "ab",
+		"items": [
+			{"name": "book", "price": 10},
+			{"name": "Pen", "price": 0, "quantity": 3, "color": "red"},
+			{"price": 1001, "quantity": 0}
+		],
+		"tags": ["a", "a", "b"]
+	}`
+
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.StringProperty("order", document, it.MatchesJSONSchema(orderJSONSchema)),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{Error: validation.ErrTooShort, PropertyPath: "order.comment"},
+		validationtest.ViolationAttributes{Error: validation.ErrInvalidEmail, PropertyPath: "order.email"},
+		validationtest.ViolationAttributes{Error: validation.ErrInvalidUUID, PropertyPath: "order.id"},
+		validationtest.ViolationAttributes{Error: validation.ErrUnexpectedProperty, PropertyPath: "order.items[1].color"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotValid, PropertyPath: "order.items[1].name"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooLow, PropertyPath: "order.items[1].price"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotDivisible, PropertyPath: "order.items[1].quantity"},
+		validationtest.ViolationAttributes{Error: validation.ErrMissingProperty, PropertyPath: "order.items[2].name"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooHighOrEqual, PropertyPath: "order.items[2].price"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooLowOrEqual, PropertyPath: "order.items[2].quantity"},
+		validationtest.ViolationAttributes{Error: validation.ErrNoSuchChoice, PropertyPath: "order.status"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooManyElements, PropertyPath: "order.tags"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotUnique, PropertyPath: "order.tags"},
+	)
+}
+
+func TestMatchesJSONSchema_WhenInvalidType_ExpectViolationWithTypeInMessage(t *testing.T) {
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.String(`{"id": 1, "items": []}`, it.MatchesJSONSchema(orderJSONSchema)),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrInvalidType,
+			Message:      "This value should be of type string.",
+			PropertyPath: "id",
+		},
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrTooFewElements,
+			Message:      "This collection should contain 1 element or more.",
+			PropertyPath: "items",
+		},
+	)
+}
+
+func TestMatchesJSONSchema_WhenMissingRootProperties_ExpectViolations(t *testing.T) {
+	err := newValidator(t).Validate(context.Background(), validation.String(`{}`, it.MatchesJSONSchema(orderJSONSchema)))
+
+	validationtest.Assert(t, err).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrMissingProperty,
+			Message:      "This field is missing.",
+			PropertyPath: "id",
+		},
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrMissingProperty,
+			Message:      "This field is missing.",
+			PropertyPath: "items",
+		},
+	)
+}
+
+func TestMatchesJSONSchema_WhenDecodedValue_ExpectViolations(t *testing.T) {
+	document := map[string]any{
+		"id":    "83eab6fd-230b-44fe-b52f-463387bd8788",
+		"items": []any{map[string]any{"name": "book", "price": -1.5}},
+	}
+
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.This[any](document, it.MatchesJSONSchema(orderJSONSchema)),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(validation.ErrTooLow).
+		WithMessage("This value should be greater than 0.").
+		WithPropertyPath("items[0].price")
+}
+
+func TestMatchesJSONSchema_WhenNumericPropertyNames_ExpectPropertyNamesInPath(t *testing.T) {
+	schema := `{"type": "object", "additionalProperties": {"type": "string"}}`
+
+	err := newValidator(t).Validate(context.Background(), validation.String(`{"0": 1}`, it.MatchesJSONSchema(schema)))
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(validation.ErrInvalidType).
+		WithPropertyPath("['0']")
+}
+
+func TestMatchesJSONSchema_WhenUnknownFormat_ExpectInvalidFormat(t *testing.T) {
+	schema := `{"type": "string", "format": "ipv4"}`
+	customSchema := `{"type": "string", "format": "duration"}`
+
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.StringProperty("ip", `"abc"`, it.MatchesJSONSchema(schema)),
+		validation.StringProperty("duration", `"abc"`, it.MatchesJSONSchema(customSchema)),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{Error: validation.ErrInvalidIP, PropertyPath: "ip"},
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrInvalidFormat,
+			Message:      "This value does not match the duration format.",
+			PropertyPath: "duration",
+		},
+	)
+}
+
+func TestMatchesJSONSchema_WhenInvalidSchema_ExpectConstraintError(t *testing.T) {
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.StringProperty("document", `{}`, it.MatchesJSONSchema(`{"type": 1}`)),
+	)
+
+	var constraintErr *validation.ConstraintError
+	if assert.True(t, errors.As(err, &constraintErr)) {
+		assert.Equal(t, "JSONSchemaConstraint", constraintErr.ConstraintName)
+		assert.Equal(t, "document", constraintErr.Path.String())
+	}
+}
+
+func TestMatchesJSONSchema_WhenIgnored_ExpectNoError(t *testing.T) {
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.String(`{}`, it.MatchesJSONSchema(orderJSONSchema).When(false)),
+	)
+
+	assertNoError(t, err)
+}
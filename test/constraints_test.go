@@ -59,6 +59,7 @@ var validateTestCases = mergeTestCases(
 	emailConstraintTestCases,
 	hasUniqueValuesTestCases,
 	hostnameConstraintTestCases,
+	idnConstraintTestCases,
 	identifierConstraintsTestCases,
 	ipConstraintTestCases,
 	cidrConstraintTestCases,
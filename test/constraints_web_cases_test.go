@@ -1,7 +1,7 @@
 package test
 
 import (
-	"net"
+	"net/netip"
 	"net/url"
 	"regexp"
 
@@ -134,6 +134,83 @@ var urlConstraintTestCases = []ConstraintValidationTestCase{
 		stringValue: stringValue("https://sample.com"),
 		assert:      assertHasOneViolation(ErrCustom, `Prohibited URL "https://sample.com" at parameter.`),
 	},
+	{
+		name:            "IsURL with denied internal targets passes on public URL",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsURL().DenyInternalTargets(),
+		stringValue:     stringValue("https://example.com/webhook"),
+		assert:          assertNoError,
+	},
+	{
+		name:            "IsURL with denied internal targets violation on metadata endpoint",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsURL().DenyInternalTargets(),
+		stringValue:     stringValue("http://169.254.169.254/latest/meta-data/"),
+		assert:          assertHasOneViolation(validation.ErrProhibitedURL, message.ProhibitedURL),
+	},
+	{
+		name:            "IsURL with denied internal targets violation on IPv4-mapped IPv6 address",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsURL().DenyInternalTargets(),
+		stringValue:     stringValue("http://[::ffff:10.0.0.1]/"),
+		assert:          assertHasOneViolation(validation.ErrProhibitedURL, message.ProhibitedURL),
+	},
+	{
+		name:            "IsURL with denied internal targets violation on decimal IPv4 address",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsURL().DenyInternalTargets(),
+		stringValue:     stringValue("http://2130706433/"),
+		assert:          assertHasOneViolation(validation.ErrProhibitedURL, message.ProhibitedURL),
+	},
+	{
+		name:            "IsURL with denied internal targets violation on user information",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsURL().DenyInternalTargets(),
+		stringValue:     stringValue("https://example.com@evil.com/"),
+		assert:          assertHasOneViolation(validation.ErrProhibitedURL, message.ProhibitedURL),
+	},
+	{
+		name:            "IsURL with denied internal targets passes on unresolved host name",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsURL().DenyInternalTargets(),
+		stringValue:     stringValue("https://internal.example.com/"),
+		assert:          assertNoError,
+	},
+	{
+		name:            "IsURL with resolver passes on public host name",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsURL().WithResolver(urlResolver),
+		stringValue:     stringValue("https://example.com/webhook"),
+		assert:          assertNoError,
+	},
+	{
+		name:            "IsURL with resolver violation on host name resolved to private IP",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsURL().WithResolver(urlResolver),
+		stringValue:     stringValue("https://internal.example.com/"),
+		assert:          assertHasOneViolation(validation.ErrProhibitedURL, message.ProhibitedURL),
+	},
+	{
+		name:            "IsURL with resolver violation on host name resolved to metadata IP",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsURL().WithResolver(urlResolver),
+		stringValue:     stringValue("https://rebind.example.com/"),
+		assert:          assertHasOneViolation(validation.ErrProhibitedURL, message.ProhibitedURL),
+	},
+	{
+		name:            "IsURL with resolver violation on unknown host name",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsURL().WithResolver(urlResolver),
+		stringValue:     stringValue("https://unknown.example.com/"),
+		assert:          assertHasOneViolation(validation.ErrProhibitedURL, message.ProhibitedURL),
+	},
+	{
+		name:            "IsURL with resolver violation on relative URL with internal IP",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsURL().WithRelativeSchema().WithResolver(urlResolver),
+		stringValue:     stringValue("//127.0.0.1/"),
+		assert:          assertHasOneViolation(validation.ErrProhibitedURL, message.ProhibitedURL),
+	},
 	{
 		name:            "IsURL passes when condition is false",
 		isApplicableFor: specificValueTypes(stringType),
@@ -186,6 +263,107 @@ var emailConstraintTestCases = []ConstraintValidationTestCase{
 		stringValue:     stringValue("invalid"),
 		assert:          assertHasOneViolation(validation.ErrInvalidEmail, message.InvalidEmail),
 	},
+	{
+		name:            "IsStrictEmail passes on quoted local part",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsStrictEmail(),
+		stringValue:     stringValue(`"john doe"@example.com`),
+		assert:          assertNoError,
+	},
+	{
+		name:            "IsStrictEmail passes on IP literal domain",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsStrictEmail(),
+		stringValue:     stringValue("user@[IPv6:2001:db8::1]"),
+		assert:          assertNoError,
+	},
+	{
+		name:            "IsStrictEmail violation on value without @",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsStrictEmail(),
+		stringValue:     stringValue("invalid"),
+		assert:          assertHasOneViolation(validation.ErrInvalidEmail, message.InvalidEmail),
+	},
+	{
+		name:            "IsStrictEmail violation on invalid local part",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsStrictEmail(),
+		stringValue:     stringValue("us..er@example.com"),
+		assert:          assertHasOneViolation(validation.ErrInvalidEmailLocalPart, message.InvalidEmailLocalPart),
+	},
+	{
+		name:            "IsStrictEmail violation on invalid domain",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsStrictEmail(),
+		stringValue:     stringValue("user@example"),
+		assert:          assertHasOneViolation(validation.ErrInvalidEmailDomain, message.InvalidEmailDomain),
+	},
+	{
+		name:            "IsStrictEmail violation on comment by default",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsStrictEmail(),
+		stringValue:     stringValue("user@example.com (work)"),
+		assert:          assertHasOneViolation(validation.ErrInvalidEmailDomain, message.InvalidEmailDomain),
+	},
+	{
+		name:            "IsStrictEmail passes on comment when comments allowed",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsStrictEmail().AllowComments(),
+		stringValue:     stringValue("user@example.com (work)"),
+		assert:          assertNoError,
+	},
+	{
+		name:            "IsStrictEmail violation on IP literal when denied",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsStrictEmail().DenyIPLiteral(),
+		stringValue:     stringValue("user@[192.0.2.1]"),
+		assert:          assertHasOneViolation(validation.ErrInvalidEmailDomain, message.InvalidEmailDomain),
+	},
+	{
+		name:            "IsStrictEmail violation on UTF-8 local part by default",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsStrictEmail(),
+		stringValue:     stringValue("пользователь@пример.рф"),
+		assert:          assertHasOneViolation(validation.ErrInvalidEmailLocalPart, message.InvalidEmailLocalPart),
+	},
+	{
+		name:            "IsStrictEmail passes on internationalized email when UTF-8 allowed",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsStrictEmail().AllowUTF8(),
+		stringValue:     stringValue("пользователь@пример.рф"),
+		assert:          assertNoError,
+	},
+	{
+		name:            "IsStrictEmail violation with custom local part error and message",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint: it.IsStrictEmail().
+			WithLocalPartError(ErrCustom).
+			WithLocalPartMessage(
+				customMessage,
+				validation.TemplateParameter{Key: "{{ custom }}", Value: "parameter"},
+			),
+		stringValue: stringValue(".user@example.com"),
+		assert:      assertHasOneViolation(ErrCustom, renderedCustomMessage),
+	},
+	{
+		name:            "IsStrictEmail violation with custom domain error and message",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint: it.IsStrictEmail().
+			WithDomainError(ErrCustom).
+			WithDomainMessage(
+				customMessage,
+				validation.TemplateParameter{Key: "{{ custom }}", Value: "parameter"},
+			),
+		stringValue: stringValue("user@-example.com"),
+		assert:      assertHasOneViolation(ErrCustom, renderedCustomMessage),
+	},
+	{
+		name:            "IsStrictEmail passes when condition is false",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsStrictEmail().When(false),
+		stringValue:     stringValue("invalid"),
+		assert:          assertNoError,
+	},
 }
 
 var ipConstraintTestCases = []ConstraintValidationTestCase{
@@ -254,12 +432,106 @@ var ipConstraintTestCases = []ConstraintValidationTestCase{
 	{
 		name:            "IsIP violation on custom IP",
 		isApplicableFor: specificValueTypes(stringType),
-		constraint: it.IsIP().DenyIP(func(ip net.IP) bool {
+		constraint: it.IsIP().DenyIP(func(ip netip.Addr) bool {
 			return ip.IsLoopback()
 		}),
 		stringValue: stringValue("127.0.0.1"),
 		assert:      assertHasOneViolation(validation.ErrProhibitedIP, message.ProhibitedIP),
 	},
+	{
+		name:            "IsIP violation on loopback IP",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsIP().DenyLoopback(),
+		stringValue:     stringValue("::ffff:127.0.0.1"),
+		assert:          assertHasOneViolation(validation.ErrProhibitedIP, message.ProhibitedIP),
+	},
+	{
+		name:            "IsIP violation on link-local IP",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsIP().DenyLinkLocal(),
+		stringValue:     stringValue("169.254.169.254"),
+		assert:          assertHasOneViolation(validation.ErrProhibitedIP, message.ProhibitedIP),
+	},
+	{
+		name:            "IsIP violation on multicast IP",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsIP().DenyMulticast(),
+		stringValue:     stringValue("ff02::1"),
+		assert:          assertHasOneViolation(validation.ErrProhibitedIP, message.ProhibitedIP),
+	},
+	{
+		name:            "IsIP violation on reserved IP",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsIP().DenyReserved(),
+		stringValue:     stringValue("192.0.2.1"),
+		assert:          assertHasOneViolation(validation.ErrProhibitedIP, message.ProhibitedIP),
+	},
+	{
+		name:            "IsIP violation on denied prefix",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsIP().DenyPrefixes(netip.MustParsePrefix("203.0.113.0/24")),
+		stringValue:     stringValue("203.0.113.10"),
+		assert:          assertHasOneViolation(validation.ErrProhibitedIP, message.ProhibitedIP),
+	},
+	{
+		name:            "IsIP passes on allowed prefix",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsIP().AllowOnlyPrefixes(netip.MustParsePrefix("10.0.0.0/8")),
+		stringValue:     stringValue("10.20.30.40"),
+		assert:          assertNoError,
+	},
+	{
+		name:            "IsIP violation on not allowed prefix",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsIP().AllowOnlyPrefixes(netip.MustParsePrefix("10.0.0.0/8")),
+		stringValue:     stringValue("11.0.0.1"),
+		assert:          assertHasOneViolation(validation.ErrProhibitedIP, message.ProhibitedIP),
+	},
+	{
+		name:            "IsIP passes on IP in subnet",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsIP().InSubnets(netip.MustParsePrefix("10.0.1.0/24")),
+		stringValue:     stringValue("10.0.1.1"),
+		assert:          assertNoError,
+	},
+	{
+		name:            "IsIP violation on IP not in subnet",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsIP().InSubnets(netip.MustParsePrefix("10.0.1.0/24")),
+		stringValue:     stringValue("10.0.2.1"),
+		assert:          assertHasOneViolation(validation.ErrIPNotInSubnet, message.IPNotInSubnet),
+	},
+	{
+		name:            "IsIP violation on IP not in subnet with custom error and message",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint: it.IsIP().
+			InSubnets(netip.MustParsePrefix("10.0.1.0/24")).
+			WithNotInSubnetError(ErrCustom).
+			WithNotInSubnetMessage(`Gateway "{{ value }}" is outside the subnet.`),
+		stringValue: stringValue("10.0.2.1"),
+		assert:      assertHasOneViolation(ErrCustom, `Gateway "10.0.2.1" is outside the subnet.`),
+	},
+	{
+		name:            "IsIPv6 violation on IP with zone",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsIPv6(),
+		stringValue:     stringValue("fe80::1%eth0"),
+		assert:          assertHasOneViolation(validation.ErrInvalidIP, message.InvalidIP),
+	},
+	{
+		name:            "IsIPv6 passes on IP with allowed zone",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsIPv6().AllowZone(),
+		stringValue:     stringValue("fe80::1%eth0"),
+		assert:          assertNoError,
+	},
+	{
+		name:            "IsIPv6 violation on link-local IP with allowed zone",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsIPv6().AllowZone().DenyLinkLocal(),
+		stringValue:     stringValue("fe80::1%eth0"),
+		assert:          assertHasOneViolation(validation.ErrProhibitedIP, message.ProhibitedIP),
+	},
 	{
 		name:            "IsIP violation with custom message",
 		isApplicableFor: specificValueTypes(stringType),
@@ -383,6 +655,63 @@ var cidrConstraintTestCases = []ConstraintValidationTestCase{
 			`The value of the netmask should be between 16 and 32.`,
 		),
 	},
+	{
+		name:            "IsCIDR passes on network within allowed networks",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsCIDR().WithinNetworks(netip.MustParsePrefix("10.0.0.0/8")),
+		stringValue:     stringValue("10.20.0.0/16"),
+		assert:          assertNoError,
+	},
+	{
+		name:            "IsCIDR violation on network not within allowed networks",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsCIDR().WithinNetworks(netip.MustParsePrefix("10.0.0.0/16")),
+		stringValue:     stringValue("10.0.0.0/8"),
+		assert:          assertHasOneViolation(validation.ErrCIDRNotInNetworks, message.CIDRNotInNetworks),
+	},
+	{
+		name:            "IsCIDR passes on network not overlapping denied networks",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsCIDR().DenyOverlapping(netip.MustParsePrefix("10.0.0.0/24"), netip.MustParsePrefix("10.0.2.0/24")),
+		stringValue:     stringValue("10.0.1.0/24"),
+		assert:          assertNoError,
+	},
+	{
+		name:            "IsCIDR violation on network overlapping denied network",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsCIDR().DenyOverlapping(netip.MustParsePrefix("10.0.0.0/24"), netip.MustParsePrefix("10.0.2.0/24")),
+		stringValue:     stringValue("10.0.2.128/25"),
+		assert: assertHasOneViolation(
+			validation.ErrCIDROverlap,
+			`This network overlaps with the network 10.0.2.0/24.`,
+		),
+	},
+	{
+		name:            "IsCIDR violation on overlapping network with custom error and message",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint: it.IsCIDR().
+			DenyOverlapping(netip.MustParsePrefix("192.168.0.0/16")).
+			WithOverlapError(ErrCustom).
+			WithOverlapMessage(`Network "{{ value }}" is already used by {{ network }}.`),
+		stringValue: stringValue("192.168.1.0/24"),
+		assert: assertHasOneViolation(
+			ErrCustom,
+			`Network "192.168.1.0/24" is already used by 192.168.0.0/16.`,
+		),
+	},
+	{
+		name:            "IsCIDR violation on network not within allowed networks with custom error and message",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint: it.IsCIDR().
+			WithinNetworks(netip.MustParsePrefix("10.0.0.0/8")).
+			WithNotInNetworksError(ErrCustom).
+			WithNotInNetworksMessage(`Network "{{ value }}" is outside the VPC.`),
+		stringValue: stringValue("172.16.0.0/12"),
+		assert: assertHasOneViolation(
+			ErrCustom,
+			`Network "172.16.0.0/12" is outside the VPC.`,
+		),
+	},
 	{
 		name:            "IsCIDR passes when When(false)",
 		isApplicableFor: specificValueTypes(stringType),
@@ -559,3 +888,103 @@ var hostnameConstraintTestCases = []ConstraintValidationTestCase{
 		assert:          assertNoError,
 	},
 }
+
+var idnConstraintTestCases = []ConstraintValidationTestCase{
+	{
+		name:            "IsIDNHostname passes on valid internationalized hostname",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsIDNHostname(),
+		stringValue:     stringValue("пример.рф"),
+		assert:          assertNoError,
+	},
+	{
+		name:            "IsIDNHostname passes on punycode hostname",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsIDNHostname(),
+		stringValue:     stringValue("xn--e1afmkfd.xn--p1ai"),
+		assert:          assertNoError,
+	},
+	{
+		name:            "IsIDNHostname violation on invalid hostname",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsIDNHostname(),
+		stringValue:     stringValue("пример-.рф"),
+		assert:          assertHasOneViolation(validation.ErrInvalidHostname, message.InvalidHostname),
+	},
+	{
+		name:            "IsIDNHostname violation on hostname without top-level domain",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsIDNHostname(),
+		stringValue:     stringValue("пример"),
+		assert:          assertHasOneViolation(validation.ErrInvalidHostname, message.InvalidHostname),
+	},
+	{
+		name:            "IsLooseIDNHostname passes on hostname without top-level domain",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsLooseIDNHostname(),
+		stringValue:     stringValue("пример"),
+		assert:          assertNoError,
+	},
+	{
+		name:            "IsIDNHostname passes on mixed-script hostname by default",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsIDNHostname(),
+		stringValue:     stringValue("pаypal.com"),
+		assert:          assertNoError,
+	},
+	{
+		name:            "IsIDNHostname violation on mixed-script hostname when mixed scripts denied",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsIDNHostname().DenyMixedScripts(),
+		stringValue:     stringValue("pаypal.com"),
+		assert: assertHasOneViolation(
+			validation.ErrSuspiciousCharactersRestriction,
+			message.SuspiciousCharactersRestriction,
+		),
+	},
+	{
+		name:            "IsIDNHostname violation with custom suspicious error and message",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint: it.IsIDNHostname().DenyMixedScripts().
+			WithSuspiciousError(ErrCustom).
+			WithSuspiciousMessage(
+				customMessage,
+				validation.TemplateParameter{Key: "{{ custom }}", Value: "parameter"},
+			),
+		stringValue: stringValue("pаypal.com"),
+		assert:      assertHasOneViolation(ErrCustom, renderedCustomMessage),
+	},
+	{
+		name:            "IsIDNEmail passes on valid internationalized email",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsIDNEmail(),
+		stringValue:     stringValue("пользователь@пример.рф"),
+		assert:          assertNoError,
+	},
+	{
+		name:            "IsIDNEmail violation on invalid email",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsIDNEmail(),
+		stringValue:     stringValue("пользователь..имя@пример.рф"),
+		assert:          assertHasOneViolation(validation.ErrInvalidEmail, message.InvalidEmail),
+	},
+	{
+		name:            "IsIDNEmail violation with custom error and message",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint: it.IsIDNEmail().
+			WithError(ErrCustom).
+			WithMessage(
+				customMessage,
+				validation.TemplateParameter{Key: "{{ custom }}", Value: "parameter"},
+			),
+		stringValue: stringValue("user@localhost"),
+		assert:      assertHasOneViolation(ErrCustom, renderedCustomMessage),
+	},
+	{
+		name:            "IsIDNEmail passes when condition is false",
+		isApplicableFor: specificValueTypes(stringType),
+		constraint:      it.IsIDNEmail().When(false),
+		stringValue:     stringValue("invalid"),
+		assert:          assertNoError,
+	},
+}
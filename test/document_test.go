@@ -0,0 +1,271 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/validationtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeDocument(t *testing.T, data string) map[string]any {
+	t.Helper()
+	var document map[string]any
+	require.NoError(t, json.Unmarshal([]byte(data), &document))
+	return document
+}
+
+func TestDocument_WhenInvalidValues_ExpectViolationsAtResolvedPaths(t *testing.T) {
+	document := decodeDocument(t, `{
+		"title": "Too long title",
+		"published": "true",
+		"publishedAt": "2020-01-01T00:00:00Z",
+		"author": {"email": "invalid"},
+		"items": [{"price": 10}, {"price": -5}, {"price": "0"}],
+		"tags": []
+	}`)
+
+	err := newValidator(t).Validate(context.Background(), validation.Document(
+		document,
+		validation.SelectString("title", it.HasMaxLength(5)),
+		validation.SelectBool("published", it.IsFalse()),
+		validation.SelectTime("publishedAt", "", it.IsLaterThan(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))),
+		validation.SelectString("author.email", it.IsEmail()),
+		validation.SelectNumber[int]("items[*].price", it.IsPositive[int]()),
+		validation.SelectCountable("tags", it.HasMinCount(1)),
+	))
+
+	validationtest.Assert(t, err).IsViolations(
+		validationtest.ViolationAttributes{Error: validation.ErrTooLong, PropertyPath: "title"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotFalse, PropertyPath: "published"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooEarly, PropertyPath: "publishedAt"},
+		validationtest.ViolationAttributes{Error: validation.ErrInvalidEmail, PropertyPath: "author.email"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotPositive, PropertyPath: "items[1].price"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotPositive, PropertyPath: "items[2].price"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooFewElements, PropertyPath: "tags"},
+	)
+}
+
+func TestDocument_WhenValidValues_ExpectNoViolations(t *testing.T) {
+	document := decodeDocument(t, `{"title": "Book", "items": [{"price": 10.5}], "rating": "4"}`)
+
+	err := newValidator(t).Validate(context.Background(), validation.Document(
+		document,
+		validation.SelectString("title", it.IsNotBlank()),
+		validation.SelectNumber[float64]("items[*].price", it.IsPositive[float64]()),
+		validation.SelectNumber[uint8]("rating", it.IsBetween[uint8](1, 5)),
+	))
+
+	assert.NoError(t, err)
+}
+
+func TestDocument_WhenMissingValues_ExpectNilConstraintsApplied(t *testing.T) {
+	document := decodeDocument(t, `{"author": null}`)
+
+	err := newValidator(t).Validate(context.Background(), validation.Document(
+		document,
+		validation.SelectString("title", it.IsNotBlank(), it.HasMaxLength(5)),
+		validation.SelectString("author.email", it.IsNotBlank()),
+		validation.SelectString("chapters[1].title", it.IsNotBlank()),
+		validation.SelectNumber[int]("items[*].price", it.IsNotBlankNumber[int]()),
+		validation.SelectCountable("tags", it.HasMinCount(1)),
+	))
+
+	validationtest.Assert(t, err).IsViolations(
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "title"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "author.email"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "chapters[1].title"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooFewElements, PropertyPath: "tags"},
+	)
+}
+
+func TestDocument_WhenWildcardOverMap_ExpectValuesInOrderOfKeys(t *testing.T) {
+	document := decodeDocument(t, `{"prices": {"usd": -1, "eur": -2, "gbp": 3}}`)
+
+	err := newValidator(t).Validate(context.Background(), validation.Document(
+		document,
+		validation.SelectNumber[float64]("prices[*]", it.IsPositive[float64]()),
+	))
+
+	validationtest.Assert(t, err).IsViolations(
+		validationtest.ViolationAttributes{Error: validation.ErrNotPositive, PropertyPath: "prices.eur"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotPositive, PropertyPath: "prices.usd"},
+	)
+}
+
+func TestDocument_WhenNestedWildcards_ExpectConcretePaths(t *testing.T) {
+	document := decodeDocument(t, `{"orders": [{"items": [{"sku": "a"}]}, {"items": [{"sku": ""}, {"sku": "b"}]}]}`)
+
+	err := newValidator(t).Validate(context.Background(), validation.DocumentProperty(
+		"request",
+		document,
+		validation.SelectString("orders[*].items[*].sku", it.IsNotBlank()),
+	))
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(validation.ErrIsBlank).
+		WithPropertyPath("request.orders[1].items[0].sku")
+}
+
+func TestDocument_WhenValueCannotBeCoerced_ExpectInvalidTypeViolation(t *testing.T) {
+	document := decodeDocument(t, `{
+		"title": {"text": "Book"},
+		"count": 12.5,
+		"price": "free",
+		"published": 1,
+		"publishedAt": 1577836800,
+		"updatedAt": "yesterday",
+		"tags": "go"
+	}`)
+
+	err := newValidator(t).Validate(context.Background(), validation.Document(
+		document,
+		validation.SelectString("title", it.IsNotBlank()),
+		validation.SelectNumber[int]("count", it.IsPositive[int]()),
+		validation.SelectNumber[float64]("price", it.IsPositive[float64]()),
+		validation.SelectBool("published", it.IsTrue()),
+		validation.SelectTime("publishedAt", ""),
+		validation.SelectTime("updatedAt", time.DateOnly),
+		validation.SelectCountable("tags", it.HasMinCount(1)),
+	))
+
+	validationtest.Assert(t, err).IsViolations(
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrInvalidType,
+			Message:      "This value should be of type string.",
+			PropertyPath: "title",
+		},
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrInvalidType,
+			Message:      "This value should be of type integer.",
+			PropertyPath: "count",
+		},
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrInvalidType,
+			Message:      "This value should be of type number.",
+			PropertyPath: "price",
+		},
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrInvalidType,
+			Message:      "This value should be of type boolean.",
+			PropertyPath: "published",
+		},
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrInvalidType,
+			Message:      "This value should be of type string.",
+			PropertyPath: "publishedAt",
+		},
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrInvalidDateTime,
+			Message:      "This value is not a valid datetime.",
+			PropertyPath: "updatedAt",
+		},
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrInvalidType,
+			Message:      "This value should be of type array.",
+			PropertyPath: "tags",
+		},
+	)
+}
+
+func TestDocument_WhenNumberOutOfRange_ExpectInvalidTypeViolation(t *testing.T) {
+	document := map[string]any{"small": 300, "negative": int64(-1), "big": uint64(1) << 63}
+
+	err := newValidator(t).Validate(context.Background(), validation.Document(
+		document,
+		validation.SelectNumber[int8]("small"),
+		validation.SelectNumber[uint]("negative"),
+		validation.SelectNumber[int64]("big"),
+	))
+
+	validationtest.Assert(t, err).IsViolations(
+		validationtest.ViolationAttributes{Error: validation.ErrInvalidType, PropertyPath: "small"},
+		validationtest.ViolationAttributes{Error: validation.ErrInvalidType, PropertyPath: "negative"},
+		validationtest.ViolationAttributes{Error: validation.ErrInvalidType, PropertyPath: "big"},
+	)
+}
+
+func TestDocument_WhenUnexpectedTypeOnPath_ExpectOneInvalidTypeViolation(t *testing.T) {
+	document := decodeDocument(t, `{"author": "John", "items": {"price": 1}, "tags": "go"}`)
+
+	err := newValidator(t).Validate(context.Background(), validation.Document(
+		document,
+		validation.SelectString("author.email", it.IsEmail()),
+		validation.SelectString("author.name", it.IsNotBlank()),
+		validation.SelectNumber[int]("items[0].price"),
+		validation.SelectString("tags[*]"),
+	))
+
+	validationtest.Assert(t, err).IsViolations(
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrInvalidType,
+			Message:      "This value should be of type object.",
+			PropertyPath: "author",
+		},
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrInvalidType,
+			Message:      "This value should be of type array.",
+			PropertyPath: "items",
+		},
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrInvalidType,
+			Message:      "This value should be of type array.",
+			PropertyPath: "tags",
+		},
+	)
+}
+
+func TestDocument_WhenTypedValues_ExpectValuesValidated(t *testing.T) {
+	document := map[string]any{
+		"tags":        []string{"go", ""},
+		"publishedAt": time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		"rating":      int32(6),
+		"title":       42,
+	}
+
+	err := newValidator(t).Validate(context.Background(), validation.Document(
+		document,
+		validation.SelectString("tags[*]", it.IsNotBlank()),
+		validation.SelectTime("publishedAt", "", it.IsLaterThan(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))),
+		validation.SelectNumber[float64]("rating", it.IsLessThanOrEqual[float64](5)),
+		validation.SelectString("title", it.HasMinLength(3)),
+	))
+
+	validationtest.Assert(t, err).IsViolations(
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "tags[1]"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooEarly, PropertyPath: "publishedAt"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooHighOrEqual, PropertyPath: "rating"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooShort, PropertyPath: "title"},
+	)
+}
+
+func TestDocument_WhenInvalidSelector_ExpectConstraintError(t *testing.T) {
+	tests := []string{"items[*", "items[**]", "items.*", "items[*]price", "[*"}
+	for _, selector := range tests {
+		t.Run(selector, func(t *testing.T) {
+			err := newValidator(t).Validate(context.Background(), validation.Document(
+				map[string]any{},
+				validation.SelectString(selector),
+			))
+
+			var constraintErr *validation.ConstraintError
+			require.True(t, errors.As(err, &constraintErr), "expected ConstraintError, got %v", err)
+			assert.Equal(t, "Document", constraintErr.ConstraintName)
+			assert.Contains(t, constraintErr.Description, `invalid selector "`+selector+`"`)
+		})
+	}
+}
+
+func TestPropertyPath_UnmarshalText_WhenWildcard_ExpectError(t *testing.T) {
+	var path validation.PropertyPath
+
+	err := path.UnmarshalText([]byte("items[*].price"))
+
+	assert.ErrorContains(t, err, "unexpected array index character")
+}
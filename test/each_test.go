@@ -115,3 +115,79 @@ func TestEach_WhenUsedWithThis_ExpectSameConstraintType(t *testing.T) {
 	errSlice := validator.Validate(context.Background(), validation.Each(slice, it.IsNotBlank()))
 	validationtest.Assert(t, errSlice).IsViolationList().WithOneViolation().WithPropertyPath("[0]")
 }
+
+func TestEachParallel_WhenInvalidElements_ExpectViolationsInOrderOfElements(t *testing.T) {
+	items := make([]string, 1000)
+	for i := range items {
+		if i%7 != 0 {
+			items[i] = "valid"
+		}
+	}
+
+	sequential := validator.Validate(context.Background(), validation.EachProperty("items", items, it.IsNotBlank()))
+	parallel := validator.Validate(context.Background(), validation.EachParallelProperty("items", items, 4, it.IsNotBlank()))
+
+	validationtest.Assert(t, parallel).IsViolationList().WithLen(143)
+	validationtest.Assert(t, parallel).IsViolationList().HasViolationAt(1).WithPropertyPath("items[7]")
+	assert.Equal(t, sequential.Error(), parallel.Error())
+}
+
+func TestEachParallel_WhenDefaultWorkers_ExpectAllViolations(t *testing.T) {
+	items := []string{"", "ok", ""}
+
+	err := validator.Validate(context.Background(), validation.EachParallel(items, 0, it.IsNotBlank()))
+
+	validationtest.Assert(t, err).IsViolationList().WithLen(2)
+	validationtest.Assert(t, err).IsViolationList().HasViolationAt(0).WithPropertyPath("[0]")
+	validationtest.Assert(t, err).IsViolationList().HasViolationAt(1).WithPropertyPath("[2]")
+}
+
+func TestEachParallel_WhenEmptySlice_ExpectNoViolations(t *testing.T) {
+	err := validator.Validate(context.Background(), validation.EachParallel([]string{}, 4, it.IsNotBlank()))
+
+	assert.NoError(t, err)
+}
+
+func TestEachParallel_WhenConstraintReturnsFatalError_ExpectErrorPropagated(t *testing.T) {
+	fatalErr := errors.New("fatal")
+	fatalConstraint := validation.Func[string](func(ctx context.Context, validator *validation.Validator, value string) error {
+		if value == "fatal" {
+			return fatalErr
+		}
+		return nil
+	})
+	items := make([]string, 100)
+	items[50] = "fatal"
+
+	err := validator.Validate(context.Background(), validation.EachParallel(items, 4, fatalConstraint))
+
+	assert.ErrorIs(t, err, fatalErr)
+}
+
+func TestEachParallel_WhenContextCanceled_ExpectContextError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := validator.Validate(ctx, validation.EachParallel([]string{"", ""}, 2, it.IsNotBlank()))
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestValidSliceParallel_WhenInvalidElements_ExpectSameViolationsAsValidSlice(t *testing.T) {
+	properties := make(Properties, 100)
+	for i := range properties {
+		if i%3 != 0 {
+			properties[i].Name = "name"
+		}
+		properties[i].Properties = Properties{{Name: ""}}
+	}
+
+	sequential := validator.Validate(context.Background(), validation.ValidSliceProperty("properties", properties))
+	parallel := validator.Validate(context.Background(), validation.ValidSliceParallelProperty("properties", properties, 3))
+
+	validationtest.Assert(t, parallel).IsViolationList().WithLen(134)
+	validationtest.Assert(t, parallel).IsViolationList().HasViolationAt(0).WithPropertyPath("properties[0].name")
+	validationtest.Assert(t, parallel).IsViolationList().HasViolationAt(1).
+		WithPropertyPath("properties[0].properties[0].name")
+	assert.Equal(t, sequential.Error(), parallel.Error())
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/muonsoft/validation/it"
 	"github.com/muonsoft/validation/validationtest"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -330,3 +332,178 @@ func TestAsyncArgument_WhenFatalError_ExpectContextCanceled(t *testing.T) {
 		assert.Fail(t, "context is expected to be canceled")
 	}
 }
+
+func TestAsyncArgument_WhenArgumentsCompleteInReverseOrder_ExpectViolationsInOrderOfArguments(t *testing.T) {
+	delayed := func(delay time.Duration, err error) validation.Argument {
+		return validation.String("", asyncConstraint(func(ctx context.Context, validator *validation.Validator, value *string) error {
+			time.Sleep(delay)
+			return validator.BuildViolation(ctx, err, err.Error()).Create()
+		}))
+	}
+
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.Async(
+			delayed(20*time.Millisecond, ErrFirst),
+			delayed(10*time.Millisecond, ErrSecond),
+			delayed(0, ErrThen),
+		),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithErrors(ErrFirst, ErrSecond, ErrThen)
+}
+
+func TestAsyncArgument_WithConcurrency_ExpectLimitedNumberOfGoroutines(t *testing.T) {
+	var running, maxRunning atomic.Int32
+	arguments := make([]validation.Argument, 10)
+	for i := range arguments {
+		arguments[i] = validation.String("", asyncConstraint(func(ctx context.Context, validator *validation.Validator, value *string) error {
+			current := running.Add(1)
+			defer running.Add(-1)
+			for {
+				observed := maxRunning.Load()
+				if current <= observed || maxRunning.CompareAndSwap(observed, current) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			return nil
+		}))
+	}
+
+	err := newValidator(t).Validate(context.Background(), validation.Async(arguments...).WithConcurrency(3))
+
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, maxRunning.Load(), int32(3))
+	assert.Equal(t, int32(0), running.Load())
+}
+
+func TestAsyncArgument_WhenFailFast_ExpectContextCanceledAndFirstViolation(t *testing.T) {
+	cancellation := make(chan bool, 1)
+
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.Async(
+			validation.String("", it.IsNotBlank().WithError(ErrFirst)),
+			validation.String("", asyncConstraint(func(ctx context.Context, validator *validation.Validator, value *string) error {
+				select {
+				case <-time.After(time.Second):
+					cancellation <- false
+					return validator.BuildViolation(ctx, ErrSecond, "second").Create()
+				case <-ctx.Done():
+					cancellation <- true
+					return ctx.Err()
+				}
+			})),
+		).FailFast(),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithErrors(ErrFirst)
+	if isCanceled := <-cancellation; !isCanceled {
+		assert.Fail(t, "context is expected to be canceled")
+	}
+}
+
+func TestAsyncArgument_WhenFailFastWithConcurrency_ExpectNextArgumentsSkipped(t *testing.T) {
+	var calls atomic.Int32
+	counted := validation.String("", asyncConstraint(func(ctx context.Context, validator *validation.Validator, value *string) error {
+		calls.Add(1)
+		return nil
+	}))
+
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.Async(
+			validation.String("", it.IsNotBlank().WithError(ErrFirst)),
+			counted,
+			counted,
+		).WithConcurrency(1).FailFast(),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithErrors(ErrFirst)
+	assert.Equal(t, int32(0), calls.Load())
+}
+
+func TestAsyncArgument_WhenNotFailFast_ExpectAllViolations(t *testing.T) {
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.Async(
+			validation.String("", it.IsNotBlank().WithError(ErrFirst)),
+			validation.String("", it.IsNotBlank().WithError(ErrSecond)),
+		).WithConcurrency(1),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithErrors(ErrFirst, ErrSecond)
+}
+
+func TestAsyncArgument_WhenFatalErrorWithConcurrency_ExpectNextArgumentsSkipped(t *testing.T) {
+	var calls atomic.Int32
+	fatal := fmt.Errorf("fatal")
+
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.Async(
+			validation.String("", asyncConstraint(func(ctx context.Context, validator *validation.Validator, value *string) error {
+				return fatal
+			})),
+			validation.String("", asyncConstraint(func(ctx context.Context, validator *validation.Validator, value *string) error {
+				calls.Add(1)
+				return nil
+			})),
+		).WithConcurrency(1),
+	)
+
+	assert.ErrorIs(t, err, fatal)
+	assert.Equal(t, int32(0), calls.Load())
+}
+
+func TestAsyncArgument_WhenArgumentPanics_ExpectPanicError(t *testing.T) {
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.Async(
+			validation.String("", it.IsNotBlank().WithError(ErrFirst)),
+			validation.String("", asyncConstraint(func(ctx context.Context, validator *validation.Validator, value *string) error {
+				panic("unexpected")
+			})),
+		).At(validation.PropertyName("book")),
+	)
+
+	var panicErr *validation.PanicError
+	require.ErrorAs(t, err, &panicErr)
+	assert.Equal(t, "unexpected", panicErr.Value)
+	assert.Equal(t, "book", panicErr.Path.String())
+	assert.NotEmpty(t, panicErr.Stack)
+	assert.Equal(t, `panic during validation at path "book": unexpected`, err.Error())
+}
+
+func TestAsyncArgument_WhenArgumentPanicsWithError_ExpectErrorUnwrapped(t *testing.T) {
+	fatal := fmt.Errorf("fatal")
+
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.Async(
+			validation.String("", asyncConstraint(func(ctx context.Context, validator *validation.Validator, value *string) error {
+				panic(fatal)
+			})),
+		),
+	)
+
+	assert.ErrorIs(t, err, fatal)
+}
+
+func TestAsyncArgument_WhenContextCanceledWhileWaitingForSlot_ExpectContextError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	err := newValidator(t).Validate(
+		ctx,
+		validation.Async(
+			validation.String("", asyncConstraint(func(ctx context.Context, validator *validation.Validator, value *string) error {
+				cancel()
+				return nil
+			})),
+			validation.String("", it.IsNotBlank()),
+		).WithConcurrency(1),
+	)
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,111 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type schemaAuthor struct {
+	Name  string
+	Email string
+}
+
+func (a schemaAuthor) Validate(ctx context.Context, validator *validation.Validator) error {
+	return validator.Validate(ctx,
+		validation.StringProperty("name", a.Name, it.IsNotBlank(), it.HasMaxLength(100)),
+		validation.StringProperty("email", a.Email, it.IsEmail()),
+	)
+}
+
+func TestDescribeJSONSchema_WhenArguments_ExpectJSONSchema(t *testing.T) {
+	authors := []schemaAuthor{{}}
+
+	schema, err := newValidator(t).DescribeJSONSchema(
+		context.Background(),
+		validation.StringProperty("email", "", it.IsNotBlank(), it.IsEmail(), it.HasMaxLength(255)),
+		validation.StringProperty("title", "", it.HasLengthBetween(1, 10), it.Matches(regexp.MustCompile(`^\w+$`))),
+		validation.StringProperty("status", "", it.IsOneOf("draft", "published")),
+		validation.StringProperty("website", "", it.IsURL()),
+		validation.StringProperty("id", "", it.IsUUID()),
+		validation.NumberProperty[int]("rating", 0, it.IsBetween(1, 5)),
+		validation.NumberProperty[float64]("price", 0, it.IsPositive[float64](), it.IsLessThanOrEqual(1000.0)),
+		validation.CountableProperty("tags", 0, it.HasCountBetween(1, 3)),
+		validation.EachStringProperty("tags", nil, it.HasMaxLength(20)),
+		validation.ComparablesProperty[string]("labels", nil, it.HasUniqueValues[string]()),
+		validation.BoolProperty("isActive", false, it.IsTrue()),
+		validation.ValidSliceProperty("authors", authors),
+		validation.AtProperty("meta", validation.StringProperty("date", "", it.IsDate())),
+		validation.StringProperty("ignored", "", it.IsNotBlank()).When(false),
+	)
+
+	require.NoError(t, err)
+	data, err := json.Marshal(schema)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"email": {"type": "string", "format": "email", "maxLength": 255},
+			"title": {"type": "string", "minLength": 1, "maxLength": 10, "pattern": "^\\w+$"},
+			"status": {"type": "string", "enum": ["draft", "published"]},
+			"website": {"type": "string", "format": "uri"},
+			"id": {"type": "string", "format": "uuid"},
+			"rating": {"type": "integer", "minimum": 1, "maximum": 5},
+			"price": {"type": "number", "exclusiveMinimum": 0, "maximum": 1000},
+			"tags": {"type": "array", "minItems": 1, "maxItems": 3, "items": {"type": "string", "maxLength": 20}},
+			"labels": {"type": "array", "uniqueItems": true},
+			"isActive": {"type": "boolean"},
+			"authors": {
+				"type": "array",
+				"items": {
+					"type": "object",
+					"properties": {
+						"name": {"type": "string", "maxLength": 100},
+						"email": {"type": "string", "format": "email"}
+					},
+					"required": ["name"]
+				}
+			},
+			"meta": {
+				"type": "object",
+				"properties": {
+					"date": {"type": "string", "format": "date"}
+				}
+			}
+		},
+		"required": ["email"]
+	}`, string(data))
+}
+
+func TestDescribeJSONSchema_WhenSingleValue_ExpectRootSchema(t *testing.T) {
+	schema, err := newValidator(t).DescribeJSONSchema(
+		context.Background(),
+		validation.String("", it.HasMinLength(5), it.HasLengthBetween(3, 10)),
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, validation.JSONSchemaDialect, schema.Schema)
+	assert.Equal(t, "string", schema.Type)
+	if assert.NotNil(t, schema.MinLength) && assert.NotNil(t, schema.MaxLength) {
+		assert.Equal(t, 5, *schema.MinLength)
+		assert.Equal(t, 10, *schema.MaxLength)
+	}
+}
+
+func TestDescribeJSONSchema_WhenConstraintError_ExpectError(t *testing.T) {
+	schema, err := newValidator(t).DescribeJSONSchema(
+		context.Background(),
+		validation.Struct("not a struct"),
+	)
+
+	assert.Nil(t, schema)
+	var constraintErr *validation.ConstraintError
+	assert.ErrorAs(t, err, &constraintErr)
+}
@@ -0,0 +1,172 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/validationtest"
+	"github.com/muonsoft/validation/validator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockValidatableBook struct {
+	title  string
+	author string
+	tags   []string
+}
+
+func (book mockValidatableBook) Validate(ctx context.Context, validator *validation.Validator) error {
+	return validator.Validate(
+		ctx,
+		validation.StringProperty("title", book.title, it.IsNotBlank()),
+		validation.StringProperty("author", book.author, it.IsNotBlank()),
+		validation.EachStringProperty("tags", book.tags, it.IsNotBlank()),
+	)
+}
+
+func unwrapViolations(t *testing.T, err error) *validation.ViolationList {
+	t.Helper()
+	violations, ok := validation.UnwrapViolations(err)
+	require.True(t, ok, "expected violations, got %v", err)
+	return violations
+}
+
+func TestValidate_WhenMaxViolationsReached_ExpectTruncatedList(t *testing.T) {
+	values := make([]string, 1000)
+
+	err := newValidator(t).WithMaxViolations(10).Validate(
+		context.Background(),
+		validation.EachStringProperty("values", values, it.IsNotBlank()),
+		validation.StringProperty("title", "", it.IsNotBlank()),
+	)
+
+	violations := unwrapViolations(t, err)
+	assert.Equal(t, 10, violations.Len())
+	assert.True(t, violations.IsTruncated())
+	validationtest.Assert(t, err).IsViolationList().
+		HasViolationAt(0).WithPropertyPath("values[0]")
+	validationtest.Assert(t, err).IsViolationList().
+		HasViolationAt(9).WithPropertyPath("values[9]")
+}
+
+func TestValidate_WhenViolationsLessThanMax_ExpectNotTruncatedList(t *testing.T) {
+	err := newValidator(t, validation.MaxViolations(2)).Validate(
+		context.Background(),
+		validation.StringProperty("title", "", it.IsNotBlank()),
+		validation.StringProperty("author", "", it.IsNotBlank()),
+	)
+
+	violations := unwrapViolations(t, err)
+	assert.Equal(t, 2, violations.Len())
+	assert.False(t, violations.IsTruncated())
+}
+
+func TestValidate_WhenMaxViolationsReachedInNestedValidation_ExpectValidationStopped(t *testing.T) {
+	books := make([]mockValidatableBook, 100)
+	for i := range books {
+		books[i].tags = []string{"", ""}
+	}
+
+	err := newValidator(t).WithMaxViolations(5).Validate(
+		context.Background(),
+		validation.ValidSliceProperty("books", books),
+	)
+
+	validationtest.Assert(t, err).IsViolations(
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "books[0].title"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "books[0].author"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "books[0].tags[0]"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "books[0].tags[1]"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "books[1].title"},
+	)
+	assert.True(t, unwrapViolations(t, err).IsTruncated())
+}
+
+func TestValidate_WhenMaxViolationsReachedInAsync_ExpectTruncatedList(t *testing.T) {
+	arguments := make([]validation.Argument, 50)
+	for i := range arguments {
+		arguments[i] = validation.StringProperty(fmt.Sprintf("value%d", i), "", it.IsNotBlank())
+	}
+
+	err := newValidator(t).WithMaxViolations(3).Validate(
+		context.Background(),
+		validation.Async(arguments...).WithConcurrency(1),
+	)
+
+	violations := unwrapViolations(t, err)
+	assert.Equal(t, 3, violations.Len())
+	assert.True(t, violations.IsTruncated())
+}
+
+func TestValidate_WhenMaxViolationsReachedInEachParallel_ExpectTruncatedList(t *testing.T) {
+	values := make([]string, 1000)
+
+	err := newValidator(t).WithMaxViolations(7).Validate(
+		context.Background(),
+		validation.EachParallel(values, 4, it.IsNotBlank()),
+	)
+
+	violations := unwrapViolations(t, err)
+	assert.Equal(t, 7, violations.Len())
+	assert.True(t, violations.IsTruncated())
+}
+
+func TestValidate_WhenMaxViolationsAndAtLeastOneOfSatisfied_ExpectDiscardedViolationsNotCounted(t *testing.T) {
+	err := newValidator(t).WithMaxViolations(1).Validate(
+		context.Background(),
+		validation.AtLeastOneOf(
+			validation.String("", it.IsNotBlank()),
+			validation.String("value", it.IsNotBlank()),
+		),
+		validation.StringProperty("title", "", it.IsNotBlank()),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().WithPropertyPath("title")
+	assert.False(t, unwrapViolations(t, err).IsTruncated())
+}
+
+func TestValidate_WhenMaxViolationsReachedInAtLeastOneOf_ExpectArgumentNotSatisfied(t *testing.T) {
+	err := newValidator(t).WithMaxViolations(1).Validate(
+		context.Background(),
+		validation.AtLeastOneOf(
+			validation.String("", it.IsNotBlank()),
+			validation.String("", it.IsNotBlank()),
+		),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().WithError(validation.ErrIsBlank)
+	assert.True(t, unwrapViolations(t, err).IsTruncated())
+}
+
+func TestValidate_WhenMaxViolationsInStruct_ExpectTruncatedList(t *testing.T) {
+	type Book struct {
+		Title  string `validate:"notblank"`
+		Author string `validate:"notblank"`
+	}
+
+	err := newValidator(t).WithMaxViolations(1).ValidateStruct(context.Background(), Book{})
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().WithPropertyPath("Title")
+	assert.True(t, unwrapViolations(t, err).IsTruncated())
+}
+
+func TestValidate_WhenSingletonWithMaxViolations_ExpectTruncatedList(t *testing.T) {
+	err := validator.WithMaxViolations(1).Validate(
+		context.Background(),
+		validation.EachString([]string{"", ""}, it.IsNotBlank()),
+	)
+
+	violations := unwrapViolations(t, err)
+	assert.Equal(t, 1, violations.Len())
+	assert.True(t, violations.IsTruncated())
+}
+
+func TestNewValidator_WhenNegativeMaxViolations_ExpectError(t *testing.T) {
+	_, err := validation.NewValidator(validation.MaxViolations(-1))
+
+	assert.ErrorContains(t, err, "maximum number of violations must not be negative")
+}
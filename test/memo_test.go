@@ -0,0 +1,139 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/validationtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoAuthor struct {
+	name  string
+	calls atomic.Int32
+}
+
+func (author *memoAuthor) Validate(ctx context.Context, validator *validation.Validator) error {
+	author.calls.Add(1)
+	return validator.Validate(ctx,
+		validation.StringProperty("name", author.name, it.IsNotBlank()),
+		validation.StringProperty("name", author.name, it.HasMinLength(3)),
+	)
+}
+
+type memoBook struct {
+	title  string
+	author *memoAuthor
+}
+
+func (book memoBook) Validate(ctx context.Context, validator *validation.Validator) error {
+	return validator.Validate(ctx,
+		validation.StringProperty("title", book.title, it.IsNotBlank()),
+		validation.ValidProperty("author", book.author),
+	)
+}
+
+type memoNode struct {
+	name     string
+	children []*memoNode
+}
+
+func (node *memoNode) Validate(ctx context.Context, validator *validation.Validator) error {
+	return validator.Validate(ctx,
+		validation.StringProperty("name", node.name, it.IsNotBlank()),
+		validation.ValidSliceProperty("children", node.children),
+	)
+}
+
+func TestValidateIt_WhenMemoization_ExpectSharedValueValidatedOnce(t *testing.T) {
+	author := &memoAuthor{}
+	books := []memoBook{{title: "First", author: author}, {author: author}}
+
+	err := newValidator(t).WithMemoization().Validate(context.Background(), validation.ValidSliceProperty("books", books))
+
+	validationtest.Assert(t, err).IsViolations(
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "books[0].author.name"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "books[1].title"},
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrIsBlank,
+			Message:      "This value should not be blank.",
+			PropertyPath: "books[1].author.name",
+		},
+	)
+	assert.Equal(t, int32(1), author.calls.Load())
+}
+
+func TestValidateIt_WhenMemoizedViolationWithParameters_ExpectSameMessage(t *testing.T) {
+	author := &memoAuthor{name: "Jo"}
+	books := []memoBook{{title: "First", author: author}, {title: "Second", author: author}}
+
+	err := newValidator(t, validation.MemoizeValidatables()).Validate(context.Background(), validation.ValidSlice(books))
+
+	message := "This value is too short. It should have 3 characters or more."
+	validationtest.Assert(t, err).IsViolations(
+		validationtest.ViolationAttributes{Error: validation.ErrTooShort, Message: message, PropertyPath: "[0].author.name"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooShort, Message: message, PropertyPath: "[1].author.name"},
+	)
+	assert.Equal(t, int32(1), author.calls.Load())
+}
+
+func TestValidateIt_WhenMemoizationInParallel_ExpectViolationsAtEveryPath(t *testing.T) {
+	author := &memoAuthor{}
+	books := make([]memoBook, 100)
+	for i := range books {
+		books[i] = memoBook{title: "Book", author: author}
+	}
+
+	err := newValidator(t).WithMemoization().Validate(context.Background(), validation.ValidSliceParallel(books, 4))
+
+	violations, ok := validation.UnwrapViolations(err)
+	require.True(t, ok)
+	assert.Equal(t, 100, violations.Len())
+	validationtest.Assert(t, err).IsViolationList().HasViolationAt(99).WithPropertyPath("[99].author.name")
+}
+
+func TestValidateIt_WhenNoMemoization_ExpectSharedValueValidatedEveryTime(t *testing.T) {
+	author := &memoAuthor{name: "John"}
+	books := []memoBook{{title: "First", author: author}, {title: "Second", author: author}}
+
+	err := newValidator(t).Validate(context.Background(), validation.ValidSlice(books))
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), author.calls.Load())
+}
+
+func TestValidateIt_WhenCyclicReference_ExpectConstraintError(t *testing.T) {
+	root := &memoNode{name: "root"}
+	child := &memoNode{name: "child", children: []*memoNode{root}}
+	root.children = []*memoNode{child}
+
+	for _, validator := range []*validation.Validator{newValidator(t), newValidator(t).WithMemoization()} {
+		err := validator.Validate(context.Background(), validation.ValidProperty("tree", root))
+
+		var constraintErr *validation.ConstraintError
+		require.True(t, errors.As(err, &constraintErr), "expected ConstraintError, got %v", err)
+		assert.Equal(t, "Valid", constraintErr.ConstraintName)
+		assert.Equal(t, "tree.children[0].children[0]", constraintErr.Path.String())
+		assert.Contains(t, constraintErr.Description, `cyclic reference: *test.memoNode is already being validated at path "tree"`)
+	}
+}
+
+func TestValidateIt_WhenSameValueInDifferentBranches_ExpectNoCycle(t *testing.T) {
+	shared := &memoNode{}
+	root := &memoNode{name: "root", children: []*memoNode{
+		{name: "left", children: []*memoNode{shared}},
+		{name: "right", children: []*memoNode{shared}},
+	}}
+
+	err := newValidator(t).Validate(context.Background(), validation.Valid(root))
+
+	validationtest.Assert(t, err).IsViolations(
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "children[0].children[0].name"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "children[1].children[0].name"},
+	)
+}
@@ -2,6 +2,8 @@ package test
 
 import (
 	"context"
+	"errors"
+	"net/netip"
 	"time"
 
 	"github.com/muonsoft/validation"
@@ -105,3 +107,21 @@ type mockFailingSliceConstraint struct{}
 func (mockFailingSliceConstraint) ValidateSlice(ctx context.Context, validator *validation.Validator, items []string) error {
 	return validator.BuildViolation(ctx, validation.ErrNotValid, "invalid").Create()
 }
+
+type mockResolver map[string][]netip.Addr
+
+func (r mockResolver) LookupNetIP(ctx context.Context, network, host string) ([]netip.Addr, error) {
+	if ips, exists := r[host]; exists {
+		return ips, nil
+	}
+
+	return nil, errNoSuchHost
+}
+
+var errNoSuchHost = errors.New("no such host")
+
+var urlResolver = mockResolver{
+	"example.com":          {netip.MustParseAddr("93.184.215.14")},
+	"internal.example.com": {netip.MustParseAddr("10.0.0.5")},
+	"rebind.example.com":   {netip.MustParseAddr("93.184.215.14"), netip.MustParseAddr("::ffff:169.254.169.254")},
+}
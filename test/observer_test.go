@@ -0,0 +1,141 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingObserver struct {
+	mu     sync.Mutex
+	events []string
+	errors []validation.ErrorEvent
+}
+
+func (observer *recordingObserver) record(event string) {
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+	observer.events = append(observer.events, event)
+}
+
+func (observer *recordingObserver) ArgumentStarted(ctx context.Context, event validation.ArgumentEvent) {
+	observer.record("argument started: " + event.PropertyPath.String())
+}
+
+func (observer *recordingObserver) ArgumentFinished(ctx context.Context, event validation.ArgumentEvent) {
+	observer.record("argument finished: " + event.PropertyPath.String())
+}
+
+func (observer *recordingObserver) ConstraintEvaluated(ctx context.Context, event validation.ConstraintEvent) {
+	observer.record("constraint evaluated: " + event.Constraint + " at " + event.PropertyPath.String())
+}
+
+func (observer *recordingObserver) ViolationCreated(ctx context.Context, violation validation.Violation) {
+	observer.record("violation created: " + violation.Unwrap().Error() + " at " + violation.PropertyPath().String())
+}
+
+func (observer *recordingObserver) ErrorReturned(ctx context.Context, event validation.ErrorEvent) {
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+	observer.errors = append(observer.errors, event)
+}
+
+func TestValidate_WhenObserverIsSet_ExpectEventsInOrder(t *testing.T) {
+	observer := &recordingObserver{}
+	book := mockValidatableBook{title: "Title", tags: []string{""}}
+
+	err := newValidator(t, validation.SetObserver(observer)).
+		Validate(context.Background(), validation.ValidProperty("book", book))
+
+	assert.Error(t, err)
+	assert.Equal(t, []string{
+		"argument started: book",
+		"argument started: book.title",
+		"constraint evaluated: it.NotBlankConstraint[string] at book.title",
+		"argument finished: book.title",
+		"argument started: book.author",
+		"violation created: is blank at book.author",
+		"constraint evaluated: it.NotBlankConstraint[string] at book.author",
+		"argument finished: book.author",
+		"argument started: book.tags",
+		"violation created: is blank at book.tags[0]",
+		"constraint evaluated: it.NotBlankConstraint[string] at book.tags[0]",
+		"argument finished: book.tags",
+		"argument finished: book",
+	}, observer.events)
+	assert.Empty(t, observer.errors)
+}
+
+func TestValidate_WhenConstraintError_ExpectErrorReturnedWithPath(t *testing.T) {
+	observer := &recordingObserver{}
+	err := newValidator(t, validation.SetObserver(observer)).Validate(
+		context.Background(),
+		validation.StringProperty("name", "value", errConstraint{}),
+	)
+
+	var constraintErr *validation.ConstraintError
+	require.True(t, errors.As(err, &constraintErr))
+	require.Len(t, observer.errors, 1)
+	assert.Equal(t, "name", observer.errors[0].PropertyPath.String())
+	assert.Same(t, err, observer.errors[0].Err)
+}
+
+func TestCountingObserver_WhenValidated_ExpectStats(t *testing.T) {
+	observer := validation.NewCountingObserver()
+
+	err := newValidator(t, validation.SetObserver(observer)).Validate(
+		context.Background(),
+		validation.EachStringProperty("names", []string{"", "ab", "name"}, it.IsNotBlank(), it.HasMinLength(3)),
+	)
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, observer.Arguments())
+	assert.Equal(t, 0, observer.Errors())
+	assert.Equal(t, map[string]int{"is blank": 1, "is too short": 1}, observer.Violations())
+	constraints := observer.Constraints()
+	assert.Equal(t, 3, constraints["it.NotBlankConstraint[string]"].Evaluations)
+	assert.Equal(t, 1, constraints["it.NotBlankConstraint[string]"].Violations)
+	assert.Equal(t, 3, constraints["it.LengthConstraint"].Evaluations)
+	assert.Equal(t, 1, constraints["it.LengthConstraint"].Violations)
+}
+
+func TestCountingObserver_WhenParallelValidation_ExpectAllViolationsCounted(t *testing.T) {
+	observer := validation.NewCountingObserver()
+	values := make([]string, 100)
+
+	err := newValidator(t, validation.SetObserver(observer)).Validate(
+		context.Background(),
+		validation.EachParallel(values, 4, it.IsNotBlank()),
+	)
+
+	assert.Error(t, err)
+	assert.Equal(t, map[string]int{"is blank": 100}, observer.Violations())
+	assert.Equal(t, 100, observer.Constraints()["it.NotBlankConstraint[string]"].Evaluations)
+}
+
+func TestSlogObserver_WhenValidated_ExpectLoggedEvents(t *testing.T) {
+	var buffer bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buffer, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	err := newValidator(t, validation.SetObserver(validation.NewSlogObserver(logger))).Validate(
+		context.Background(),
+		validation.StringProperty("name", "", it.IsNotBlank()),
+	)
+
+	assert.Error(t, err)
+	output := buffer.String()
+	assert.Contains(t, output, `msg="validation argument started" path=name`)
+	assert.Contains(t, output, `msg="validation violation created" path=name code="is blank"`)
+	assert.Contains(t, output, `msg="validation constraint evaluated" constraint=it.NotBlankConstraint[string] path=name`)
+	assert.Contains(t, output, `codes="[is blank]"`)
+	assert.Contains(t, output, `msg="validation argument finished" path=name`)
+	assert.Contains(t, output, `violations=1`)
+}
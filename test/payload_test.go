@@ -0,0 +1,77 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type uiHint struct {
+	Highlight string
+	DocsURL   string
+}
+
+func TestValidate_WhenConstraintWithPayload_ExpectPayloadInViolation(t *testing.T) {
+	hint := uiHint{Highlight: "red", DocsURL: "https://example.com/docs/name"}
+
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.StringProperty("name", "", it.IsNotBlank().WithPayload(hint)),
+		validation.StringProperty("title", ""),
+		validation.StringProperty("code", "", it.IsNotBlank()),
+	)
+
+	violations := unwrapViolations(t, err)
+	require.Equal(t, 2, violations.Len())
+	assert.Equal(t, hint, validation.PayloadOf(violations.First()))
+	assert.Equal(t, hint, violations.First().Payload())
+	assert.Nil(t, violations.Last().Payload())
+}
+
+func TestValidate_WhenListConstraintWithPayload_ExpectPayloadInEachViolation(t *testing.T) {
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.Slice([]string{"a", "a"}, it.HasUniqueValuesBy(func(s string) string { return s }).WithPayload("duplicate")),
+	)
+
+	for _, violation := range unwrapViolations(t, err).All() {
+		assert.Equal(t, "duplicate", validation.PayloadOf(violation))
+	}
+}
+
+func TestValidate_WhenCheckerWithPayload_ExpectPayloadInViolation(t *testing.T) {
+	err := newValidator(t).Validate(context.Background(), validation.CheckProperty("agreed", false).WithPayload(42))
+
+	assert.Equal(t, 42, unwrapViolations(t, err).First().Payload())
+}
+
+func TestViolationBuilder_WithPayload_ExpectPayloadInViolation(t *testing.T) {
+	violation := newValidator(t).BuildViolation(context.Background(), validation.ErrNotValid, "message").
+		WithPayload(map[string]string{"remediation": "R-1"}).
+		Create()
+
+	assert.Equal(t, map[string]string{"remediation": "R-1"}, validation.PayloadOf(violation))
+}
+
+func TestValidate_WhenMemoizedViolationWithPayload_ExpectPayloadKept(t *testing.T) {
+	shared := &payloadItem{}
+	items := []*payloadItem{shared, shared}
+
+	err := newValidator(t).WithMemoization().Validate(context.Background(), validation.ValidSlice(items))
+
+	violations := unwrapViolations(t, err)
+	require.Equal(t, 2, violations.Len())
+	assert.Equal(t, "hint", violations.Last().Payload())
+}
+
+type payloadItem struct {
+	name string
+}
+
+func (item *payloadItem) Validate(ctx context.Context, validator *validation.Validator) error {
+	return validator.Validate(ctx, validation.StringProperty("name", item.name, it.IsNotBlank().WithPayload("hint")))
+}
@@ -0,0 +1,98 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/validationtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstraintRegistry_WhenBuiltinConstraintsRegistered_ExpectConstraintResolved(t *testing.T) {
+	registry := validation.NewConstraintRegistry()
+	it.RegisterConstraints(registry)
+
+	constraint, err := registry.Constraint("length", validation.StringType, "1..5")
+
+	assert.NoError(t, err)
+	assert.Implements(t, (*validation.StringConstraint)(nil), constraint)
+	assert.True(t, registry.Has("notblank"))
+	assert.Contains(t, registry.Names(), "email")
+}
+
+func TestConstraintRegistry_WhenUnknownConstraint_ExpectConstraintNotFoundError(t *testing.T) {
+	registry := validation.NewConstraintRegistry()
+
+	constraint, err := registry.Constraint("unknown", validation.IntType, "")
+
+	assert.Nil(t, constraint)
+	var notFoundErr *validation.ConstraintNotFoundError
+	if assert.True(t, errors.As(err, &notFoundErr)) {
+		assert.Equal(t, "unknown", notFoundErr.Key)
+		assert.Equal(t, "int", notFoundErr.Type)
+	}
+}
+
+func TestConstraintRegistry_Names_ExpectSortedNames(t *testing.T) {
+	registry := validation.NewConstraintRegistry()
+	factory := func(valueType validation.ValueType, options validation.ConstraintOptions) (any, error) {
+		return it.IsNotBlank(), nil
+	}
+	registry.Register("b", factory)
+	registry.Register("a", factory)
+
+	assert.Equal(t, []string{"a", "b"}, registry.Names())
+}
+
+func TestSetConstraintRegistry_WhenCustomRegistry_ExpectBuiltinConstraintsNotFound(t *testing.T) {
+	validator := newValidator(t, validation.SetConstraintRegistry(validation.NewConstraintRegistry()))
+
+	err := validator.ValidateStruct(context.Background(), struct {
+		Email string `validate:"email"`
+	}{})
+
+	var notFoundErr *validation.ConstraintNotFoundError
+	if assert.True(t, errors.As(err, &notFoundErr)) {
+		assert.Equal(t, "email", notFoundErr.Key)
+	}
+}
+
+func TestSetConstraintRegistry_WhenCustomConstraintViolated_ExpectViolation(t *testing.T) {
+	registry := validation.NewConstraintRegistry()
+	registry.Register("code", func(valueType validation.ValueType, options validation.ConstraintOptions) (any, error) {
+		length, err := options.Int()
+		if err != nil {
+			return nil, err
+		}
+		return it.HasExactLength(length), nil
+	})
+	validator := newValidator(t, validation.SetConstraintRegistry(registry))
+
+	err := validator.ValidateStruct(context.Background(), struct {
+		Code string `json:"code" validate:"code=3"`
+	}{Code: "abcd"})
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(validation.ErrNotExactLength).
+		WithPropertyPath("code")
+}
+
+func TestConstraintOptions_ExpectTypedValues(t *testing.T) {
+	vMin, vMax, isRange := validation.ConstraintOptions("1..10").Range()
+	assert.True(t, isRange)
+	assert.Equal(t, validation.ConstraintOptions("1"), vMin)
+	assert.Equal(t, validation.ConstraintOptions("10"), vMax)
+	assert.Equal(t, []validation.ConstraintOptions{"a", "b"}, validation.ConstraintOptions("a|b").List())
+	assert.Nil(t, validation.ConstraintOptions("").List())
+	f, err := validation.ConstraintOptions(" 1.5 ").Float64()
+	assert.NoError(t, err)
+	assert.InDelta(t, 1.5, f, 0)
+	b, err := validation.ConstraintOptions("true").Bool()
+	assert.NoError(t, err)
+	assert.True(t, b)
+	_, err = validation.ConstraintOptions("a").Int()
+	assert.EqualError(t, err, `parse options "a" as int: strconv.Atoi: parsing "a": invalid syntax`)
+}
@@ -0,0 +1,161 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/validationtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const ruleSetJSON = `{
+	"title": ["notblank", "length=1..10"],
+	"rating": ["between=0..5"],
+	"tags": ["count=1..3"],
+	"author.email": ["notblank", "email"],
+	"chapters[1].Title": ["length=1..5"],
+	"missing": ["length=1..5"]
+}`
+
+func TestRuleSet_WhenMapIsInvalid_ExpectViolations(t *testing.T) {
+	ruleSet, err := validation.ParseRuleSetJSON([]byte(ruleSetJSON))
+	require.NoError(t, err)
+	var value map[string]any
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"title": "Too long title",
+		"rating": 6,
+		"tags": [],
+		"author": {"email": "invalid"},
+		"chapters": [{"Title": "first"}, {"Title": "second"}]
+	}`), &value))
+
+	err = newValidator(t).Validate(context.Background(), ruleSet.For(value))
+
+	validationtest.Assert(t, err).IsViolations(
+		validationtest.ViolationAttributes{Error: validation.ErrInvalidEmail, PropertyPath: "author.email"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooLong, PropertyPath: "chapters[1].Title"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotInRange, PropertyPath: "rating"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooFewElements, PropertyPath: "tags"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooLong, PropertyPath: "title"},
+	)
+}
+
+func TestRuleSet_WhenMapWithMissingValues_ExpectNilConstraintsApplied(t *testing.T) {
+	ruleSet, err := validation.ParseRuleSetJSON([]byte(ruleSetJSON))
+	require.NoError(t, err)
+
+	err = newValidator(t).Validate(context.Background(), ruleSet.For(map[string]any{"tags": []any{"tag"}}))
+
+	validationtest.Assert(t, err).IsViolations(
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "author.email"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "title"},
+	)
+}
+
+func TestRuleSet_WhenStructIsInvalid_ExpectViolations(t *testing.T) {
+	ruleSet, err := validation.NewRuleSet(validation.RuleSetSchema{
+		"title":        {"length=1..10"},
+		"author.email": {"email"},
+	})
+	require.NoError(t, err)
+
+	err = newValidator(t).Validate(context.Background(), ruleSet.For(&structBook{
+		Title:  "Too long title",
+		Author: structAuthor{Email: "invalid"},
+	}))
+
+	validationtest.Assert(t, err).IsViolations(
+		validationtest.ViolationAttributes{Error: validation.ErrInvalidEmail, PropertyPath: "author.email"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooLong, PropertyPath: "title"},
+	)
+}
+
+func TestRuleSet_WhenValid_ExpectNoViolations(t *testing.T) {
+	ruleSet, err := validation.NewRuleSet(validation.RuleSetSchema{"name": {"notblank"}})
+	require.NoError(t, err)
+
+	err = newValidator(t).Validate(context.Background(), ruleSet.For(map[string]string{"name": "value"}))
+
+	assertNoError(t, err)
+}
+
+func TestNewRuleSet_WhenInvalidSchema_ExpectConstraintError(t *testing.T) {
+	tests := []struct {
+		name          string
+		schema        validation.RuleSetSchema
+		expectedError string
+	}{
+		{
+			name:          "invalid path",
+			schema:        validation.RuleSetSchema{"tags[": {"notblank"}},
+			expectedError: `validate by RuleSet: invalid property path "tags[": parsing path element #1: incomplete array index`,
+		},
+		{
+			name:          "empty rule name",
+			schema:        validation.RuleSetSchema{"tags[0]": {"=1"}},
+			expectedError: `validate by RuleSet at path "tags[0]": invalid rule "=1": empty constraint name`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ruleSet, err := validation.NewRuleSet(test.schema)
+
+			assert.Nil(t, ruleSet)
+			var constraintErr *validation.ConstraintError
+			if assert.True(t, errors.As(err, &constraintErr)) {
+				assert.Equal(t, test.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestRuleSet_WhenInvalidConfiguration_ExpectConstraintError(t *testing.T) {
+	tests := []struct {
+		name          string
+		schema        validation.RuleSetSchema
+		value         any
+		expectedError string
+	}{
+		{
+			name:          "unknown constraint",
+			schema:        validation.RuleSetSchema{"name": {"unknown"}},
+			value:         map[string]any{"name": "value"},
+			expectedError: `validate by unknown at path "name": constraint by key "unknown" of type "string" is not found`,
+		},
+		{
+			name:          "path not resolved",
+			schema:        validation.RuleSetSchema{"name.first": {"notblank"}},
+			value:         map[string]any{"name": "value"},
+			expectedError: `validate by RuleSet at path "name.first": cannot resolve path: property "first" of value of type "string"`,
+		},
+		{
+			name:          "unknown struct field",
+			schema:        validation.RuleSetSchema{"unknown": {"notblank"}},
+			value:         structAuthor{},
+			expectedError: `validate by RuleSet at path "unknown": cannot resolve path: property "unknown" of value of type "test.structAuthor"`,
+		},
+		{
+			name:          "invalid options",
+			schema:        validation.RuleSetSchema{"name": {"length=a"}},
+			value:         map[string]any{"name": "value"},
+			expectedError: `validate by length at path "name": invalid options "a": expected value of type int`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ruleSet, err := validation.NewRuleSet(test.schema)
+			require.NoError(t, err)
+
+			err = newValidator(t).Validate(context.Background(), ruleSet.For(test.value))
+
+			var constraintErr *validation.ConstraintError
+			if assert.True(t, errors.As(err, &constraintErr)) {
+				assert.Equal(t, test.expectedError, err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/validationtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate_WhenSensitiveArgument_ExpectMaskedParameters(t *testing.T) {
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.StringProperty("password", "secret", it.IsEqualTo("password")).Sensitive(),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(validation.ErrNotEqual).
+		WithMessage("This value should be equal to [REDACTED].").
+		WithPropertyPath("password")
+	violation := unwrapViolations(t, err).First()
+	assert.Equal(t, []validation.TemplateParameter{
+		{Key: "{{ comparedValue }}", Value: validation.RedactedValue, Sensitive: true},
+		{Key: "{{ value }}", Value: validation.RedactedValue, Sensitive: true},
+	}, violation.Parameters())
+	assert.Equal(t, []validation.TemplateParameter{
+		{Key: "{{ comparedValue }}", Value: `"password"`, Sensitive: true},
+		{Key: "{{ value }}", Value: `"secret"`, Sensitive: true},
+	}, validation.UnsafeParameters(violation))
+}
+
+func TestValidate_WhenSensitiveConstraint_ExpectOnlyItsParametersMasked(t *testing.T) {
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.StringProperty("pin", "1234", it.IsNotEqualTo("1234").Sensitive()),
+		validation.StringProperty("role", "root", it.IsOneOf("admin", "user")),
+		validation.StringProperty("token", "abc", it.IsOneOf("xyz").Sensitive()),
+	)
+
+	violations := unwrapViolations(t, err)
+	require.Equal(t, 3, violations.Len())
+	assert.Equal(t, "This value should not be equal to [REDACTED].", violations.AsSlice()[0].Message())
+	assert.Equal(t, "The value you selected is not a valid choice.", violations.AsSlice()[1].Message())
+	assert.Equal(t, "root", violations.AsSlice()[1].Parameters()[0].Value)
+	assert.Equal(t, validation.RedactedValue, violations.AsSlice()[2].Parameters()[0].Value)
+	assert.Equal(t, "abc", validation.UnsafeParameters(violations.AsSlice()[2])[0].Value)
+}
+
+func TestValidate_WhenSensitiveNestedArgument_ExpectMaskedNestedViolations(t *testing.T) {
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.ValidProperty("account", validation.ValidatableFunc(func(ctx context.Context, validator *validation.Validator) error {
+			return validator.Validate(ctx, validation.StringProperty("cardNumber", "1234", it.HasMinLength(12)))
+		})).Sensitive(),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithPropertyPath("account.cardNumber").
+		WithMessage("This value is too short. It should have [REDACTED] characters or more.")
+}
+
+func TestValidate_WhenSensitiveViolationSerialized_ExpectNoSecrets(t *testing.T) {
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.StringProperty("password", "secret", it.IsEqualTo("password")).Sensitive(),
+	)
+
+	data, jsonErr := json.Marshal(validation.NewViolationListData(unwrapViolations(t, err)))
+	require.NoError(t, jsonErr)
+
+	assert.NotContains(t, string(data), "secret")
+	assert.Contains(t, string(data), `{"key":"{{ value }}","value":"[REDACTED]","sensitive":true}`)
+}
+
+func TestValidate_WhenSensitiveMemoizedValue_ExpectUnsafeParametersKept(t *testing.T) {
+	author := &memoAuthor{name: "Jo"}
+	books := []memoBook{{title: "First", author: author}, {title: "Second", author: author}}
+
+	err := newValidator(t).WithMemoization().Validate(context.Background(), validation.ValidSlice(books).Sensitive())
+
+	violations := unwrapViolations(t, err)
+	require.Equal(t, 2, violations.Len())
+	for _, violation := range violations.All() {
+		assert.Equal(t, "This value is too short. It should have [REDACTED] characters or more.", violation.Message())
+		assert.Equal(t, "3", validation.UnsafeParameters(violation)[2].Value)
+	}
+}
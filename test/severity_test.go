@@ -0,0 +1,175 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/validationtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate_WhenOnlyWarnings_ExpectNoError(t *testing.T) {
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.StringProperty("password", "abc", it.HasMinLength(8).WithSeverity(validation.SeverityWarning)),
+	)
+
+	assert.NoError(t, err)
+}
+
+func TestValidateWithWarnings_WhenOnlyWarnings_ExpectWarningsAndNoError(t *testing.T) {
+	warnings, err := newValidator(t).ValidateWithWarnings(
+		context.Background(),
+		validation.StringProperty("password", "abc", it.HasMinLength(8).WithSeverity(validation.SeverityWarning)),
+		validation.StringProperty("url", "http://example.com",
+			it.Matches(regexp.MustCompile(`^https:`)).WithSeverity(validation.SeverityNotice),
+		),
+	)
+
+	require.NoError(t, err)
+	validationtest.Assert(t, warnings).IsViolationList().WithLen(2)
+	validationtest.Assert(t, warnings).IsViolationList().HasViolationAt(0).
+		WithError(validation.ErrTooShort).
+		WithPropertyPath("password").
+		WithSeverity(validation.SeverityWarning)
+	validationtest.Assert(t, warnings).IsViolationList().HasViolationAt(1).
+		WithPropertyPath("url").
+		WithSeverity(validation.SeverityNotice)
+}
+
+func TestValidate_WhenErrorsAndWarnings_ExpectAllViolationsInError(t *testing.T) {
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.StringProperty("name", "", it.IsNotBlank()),
+		validation.StringProperty("password", "abc", it.HasMinLength(8).WithSeverity(validation.SeverityWarning)),
+	)
+
+	violations := unwrapViolations(t, err)
+	assert.Equal(t, 2, violations.Len())
+	assert.True(t, violations.HasErrors())
+	validationtest.Assert(t, violations.Errors()).IsViolations(
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "name"},
+	)
+	validationtest.Assert(t, violations.Warnings()).IsViolations(
+		validationtest.ViolationAttributes{Error: validation.ErrTooShort, PropertyPath: "password"},
+	)
+	assert.Equal(t, 1, violations.WithSeverity(validation.SeverityWarning, validation.SeverityNotice).Len())
+}
+
+func TestValidateWithWarnings_WhenNestedWarnings_ExpectWarningsPassedFromNestedValidation(t *testing.T) {
+	account := validation.ValidatableFunc(func(ctx context.Context, validator *validation.Validator) error {
+		return validator.Validate(ctx,
+			validation.StringProperty("password", "abc", it.HasMinLength(8).WithSeverity(validation.SeverityWarning)),
+		)
+	})
+
+	warnings, err := newValidator(t).ValidateWithWarnings(
+		context.Background(),
+		validation.ValidSliceProperty("accounts", []validation.ValidatableFunc{account}),
+	)
+
+	require.NoError(t, err)
+	validationtest.Assert(t, warnings).IsViolationList().WithOneViolation().
+		WithPropertyPath("accounts[0].password").
+		WithSeverity(validation.SeverityWarning)
+}
+
+func TestViolationList_AsError_WhenOnlyWarnings_ExpectNil(t *testing.T) {
+	violation := newValidator(t).BuildViolation(context.Background(), validation.ErrNotValid, "message").
+		WithSeverity(validation.SeverityWarning).
+		Create()
+
+	assert.NoError(t, validation.NewViolationList(violation).AsError())
+	assert.Equal(t, validation.SeverityWarning, validation.SeverityOf(violation))
+}
+
+func TestViolationList_AsError_WhenErrorAndWarning_ExpectWholeList(t *testing.T) {
+	validator := newValidator(t)
+	warning := validator.BuildViolation(context.Background(), validation.ErrNotValid, "warning").
+		WithSeverity(validation.SeverityWarning).
+		Create()
+	violation := validator.BuildViolation(context.Background(), validation.ErrNotValid, "error").Create()
+
+	err := validation.NewViolationList(warning, violation).AsError()
+
+	validationtest.Assert(t, err).IsViolationList().WithLen(2)
+}
+
+func TestFilter_WhenOnlyWarnings_ExpectNil(t *testing.T) {
+	violation := newValidator(t).BuildViolation(context.Background(), validation.ErrNotValid, "message").
+		WithSeverity(validation.SeverityWarning).
+		Create()
+
+	assert.NoError(t, validation.Filter(violation))
+}
+
+func TestValidate_WhenSequentialWithWarning_ExpectNextArgumentValidated(t *testing.T) {
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.Sequentially(
+			validation.String("abc", it.HasMinLength(8).WithSeverity(validation.SeverityWarning)),
+			validation.String("abc", it.IsEqualTo("value")),
+		),
+	)
+
+	validationtest.Assert(t, err).IsViolations(
+		validationtest.ViolationAttributes{Error: validation.ErrTooShort},
+		validationtest.ViolationAttributes{Error: validation.ErrNotEqual},
+	)
+}
+
+func TestValidateWithWarnings_WhenAtLeastOneOfWithWarning_ExpectSatisfied(t *testing.T) {
+	warnings, err := newValidator(t).ValidateWithWarnings(
+		context.Background(),
+		validation.AtLeastOneOf(
+			validation.String("abc", it.IsEqualTo("value")),
+			validation.String("abc", it.HasMinLength(8).WithSeverity(validation.SeverityWarning)),
+		),
+	)
+
+	require.NoError(t, err)
+	validationtest.Assert(t, warnings).IsViolations(
+		validationtest.ViolationAttributes{Error: validation.ErrTooShort},
+	)
+}
+
+func TestValidateWithWarnings_WhenUniqueByWithWarning_ExpectWarnings(t *testing.T) {
+	warnings, err := newValidator(t).ValidateWithWarnings(
+		context.Background(),
+		validation.SliceProperty("tags", []string{"a", "a"}, it.HasUniqueValuesBy(func(s string) string { return s }).
+			WithSeverity(validation.SeverityNotice)),
+	)
+
+	require.NoError(t, err)
+	validationtest.Assert(t, warnings).IsViolationList().WithLen(2).
+		HasViolationAt(1).WithPropertyPath("tags[1]").WithSeverity(validation.SeverityNotice)
+}
+
+func TestViolationData_WhenWarning_ExpectSeverityKept(t *testing.T) {
+	violation := newValidator(t).BuildViolation(context.Background(), validation.ErrNotValid, "message").
+		WithSeverity(validation.SeverityWarning).
+		Create()
+
+	data, err := json.Marshal(validation.NewViolationData(violation))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"severity":"warning"`)
+
+	var decoded validation.ViolationData
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	restored, err := decoded.Violation()
+	require.NoError(t, err)
+	assert.Equal(t, validation.SeverityWarning, validation.SeverityOf(restored))
+}
+
+func TestSeverity_UnmarshalText_WhenUnknown_ExpectError(t *testing.T) {
+	var severity validation.Severity
+
+	err := severity.UnmarshalText([]byte("fatal"))
+
+	assert.EqualError(t, err, `unknown severity: "fatal"`)
+}
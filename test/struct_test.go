@@ -0,0 +1,262 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/validationtest"
+	"github.com/stretchr/testify/assert"
+)
+
+type structAuthor struct {
+	Name  string `json:"name" validate:"notblank"`
+	Email string `json:"email,omitempty" validate:"email"`
+}
+
+type structChapter struct {
+	Title string `validate:"notblank,length=1..10"`
+}
+
+type structBook struct {
+	Title     string                  `json:"title" validate:"notblank,length=1..255"`
+	Pages     int                     `json:"pages" validate:"positive"`
+	Rating    float64                 `json:"rating" validate:"between=0..5"`
+	Count     uint8                   `json:"count" validate:"max=10"`
+	Tags      []string                `json:"tags" validate:"count=1..3"`
+	Status    string                  `json:"status" validate:"oneof=draft|published"`
+	Published *time.Time              `json:"published" validate:"notnil"`
+	IsActive  bool                    `json:"isActive" validate:"true"`
+	Author    structAuthor            `json:"author"`
+	CoAuthor  *structAuthor           `json:"coAuthor"`
+	Chapters  []structChapter         `json:"chapters"`
+	Editions  map[string]structAuthor `json:"editions"`
+	Ignored   string                  `json:"ignored" validate:"-"`
+	internal  string                  `validate:"notblank"`
+}
+
+type structBase struct {
+	ID string `json:"id" validate:"uuid"`
+}
+
+type structEmbedding struct {
+	structBase
+	Name string `json:"name" validate:"notblank"`
+}
+
+type structWithValidatable struct {
+	Value mockValidatableString `json:"value"`
+	Items []mockValidatableString
+}
+
+type structWithUnknownConstraint struct {
+	Value string `validate:"unknown"`
+}
+
+type structWithNotApplicableConstraint struct {
+	Value int `validate:"email"`
+}
+
+type structWithInvalidOptions struct {
+	Value string `validate:"length=a..b"`
+}
+
+type structWithComparison struct {
+	Role  string `json:"role" validate:"eq=user"`
+	Login string `json:"login" validate:"ne=admin"`
+	Level int    `json:"level" validate:"eq=1"`
+}
+
+type structWithEscapedOptions struct {
+	Value string `validate:"regexp=^[a-z]{1\\,3}$"`
+}
+
+func TestStruct_WhenValidStruct_ExpectNoViolations(t *testing.T) {
+	published := time.Now()
+	book := structBook{
+		Title:     "Title",
+		Pages:     100,
+		Rating:    4.5,
+		Count:     5,
+		Tags:      []string{"tag"},
+		Status:    "draft",
+		Published: &published,
+		IsActive:  true,
+		Author:    structAuthor{Name: "Author"},
+		CoAuthor:  &structAuthor{Name: "CoAuthor", Email: "coauthor@example.com"},
+		Chapters:  []structChapter{{Title: "Chapter"}},
+		Editions:  map[string]structAuthor{"first": {Name: "Editor"}},
+		internal:  "",
+	}
+
+	err := newValidator(t).ValidateStruct(context.Background(), book)
+
+	assertNoError(t, err)
+}
+
+func TestStruct_WhenInvalidStruct_ExpectViolationsAtPropertyPaths(t *testing.T) {
+	book := &structBook{
+		Title:    "",
+		Pages:    -1,
+		Rating:   10,
+		Count:    11,
+		Status:   "unknown",
+		CoAuthor: &structAuthor{Name: "CoAuthor", Email: "invalid"},
+		Chapters: []structChapter{{Title: "Chapter"}, {Title: "Too long chapter title"}},
+		Editions: map[string]structAuthor{"second": {}, "first": {}},
+	}
+
+	err := newValidator(t).Validate(context.Background(), validation.Struct(book))
+
+	validationtest.Assert(t, err).IsViolations(
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "title"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotPositive, PropertyPath: "pages"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotInRange, PropertyPath: "rating"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooHighOrEqual, PropertyPath: "count"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooFewElements, PropertyPath: "tags"},
+		validationtest.ViolationAttributes{Error: validation.ErrNoSuchChoice, PropertyPath: "status"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsNil, PropertyPath: "published"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotTrue, PropertyPath: "isActive"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "author.name"},
+		validationtest.ViolationAttributes{Error: validation.ErrInvalidEmail, PropertyPath: "coAuthor.email"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooLong, PropertyPath: "chapters[1].Title"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "editions.first.name"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "editions.second.name"},
+	)
+}
+
+func TestStruct_WhenEmbeddedStruct_ExpectFieldsOfParentStruct(t *testing.T) {
+	err := newValidator(t).ValidateStruct(context.Background(), structEmbedding{
+		structBase: structBase{ID: "invalid"},
+	})
+
+	validationtest.Assert(t, err).IsViolations(
+		validationtest.ViolationAttributes{Error: validation.ErrInvalidUUID, PropertyPath: "id"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "name"},
+	)
+}
+
+func TestStruct_WhenNestedValidatable_ExpectValidateMethodUsed(t *testing.T) {
+	err := newValidator(t).ValidateStruct(context.Background(), structWithValidatable{
+		Items: []mockValidatableString{{value: "valid"}, {value: ""}},
+	})
+
+	validationtest.Assert(t, err).IsViolations(
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "value.value"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "Items[1].value"},
+	)
+}
+
+func TestStructProperty_WhenInvalidStruct_ExpectPropertyPathPrefix(t *testing.T) {
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.StructProperty("author", structAuthor{}),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(validation.ErrIsBlank).
+		WithPropertyPath("author.name")
+}
+
+func TestStruct_WhenEscapedCommaInOptions_ExpectOptionsParsed(t *testing.T) {
+	validator := newValidator(t)
+
+	assertNoError(t, validator.ValidateStruct(context.Background(), structWithEscapedOptions{Value: "abc"}))
+	validationtest.Assert(t, validator.ValidateStruct(context.Background(), structWithEscapedOptions{Value: "abcd"})).
+		IsViolationList().WithOneViolation().WithError(validation.ErrNotValid)
+}
+
+func TestStruct_WhenComparisonTagsAndValidValues_ExpectNoViolations(t *testing.T) {
+	err := newValidator(t).ValidateStruct(
+		context.Background(),
+		structWithComparison{Role: "user", Login: "john", Level: 1},
+	)
+
+	assertNoError(t, err)
+}
+
+func TestStruct_WhenComparisonTagsAndInvalidValues_ExpectViolations(t *testing.T) {
+	err := newValidator(t).ValidateStruct(
+		context.Background(),
+		structWithComparison{Role: "guest", Login: "admin", Level: 2},
+	)
+
+	validationtest.Assert(t, err).IsViolations(
+		validationtest.ViolationAttributes{Error: validation.ErrNotEqual, PropertyPath: "role"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsEqual, PropertyPath: "login"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotEqual, PropertyPath: "level"},
+	)
+}
+
+func TestStruct_WhenNilValue_ExpectNoViolations(t *testing.T) {
+	var author *structAuthor
+
+	err := newValidator(t).ValidateStruct(context.Background(), author)
+
+	assertNoError(t, err)
+}
+
+func TestStruct_WhenInvalidConfiguration_ExpectConstraintError(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         any
+		expectedError string
+	}{
+		{
+			name:          "not a struct",
+			value:         "string",
+			expectedError: `validate by Struct: value of type "string" is not a struct`,
+		},
+		{
+			name:          "not applicable constraint",
+			value:         structWithNotApplicableConstraint{},
+			expectedError: `validate by email at path "Value": unsupported value type "int"`,
+		},
+		{
+			name:          "invalid options",
+			value:         structWithInvalidOptions{},
+			expectedError: `validate by length at path "Value": invalid options "a": expected value of type int`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := newValidator(t).ValidateStruct(context.Background(), test.value)
+
+			var constraintErr *validation.ConstraintError
+			if assert.True(t, errors.As(err, &constraintErr)) {
+				assert.Equal(t, test.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestStruct_WhenUnknownConstraint_ExpectConstraintNotFoundError(t *testing.T) {
+	err := newValidator(t).ValidateStruct(context.Background(), structWithUnknownConstraint{})
+
+	var notFoundErr *validation.ConstraintNotFoundError
+	if assert.True(t, errors.As(err, &notFoundErr)) {
+		assert.Equal(t, "unknown", notFoundErr.Key)
+		assert.Equal(t, `constraint by key "unknown" of type "string" is not found`, err.Error())
+	}
+}
+
+func TestRegisterConstraint_WhenCustomConstraint_ExpectConstraintUsedInStructTag(t *testing.T) {
+	validation.RegisterConstraint(
+		"test_custom",
+		func(valueType validation.ValueType, options validation.ConstraintOptions) (any, error) {
+			return it.IsEqualTo(options.String()), nil
+		},
+	)
+	value := struct {
+		Value string `validate:"test_custom=expected"`
+	}{Value: "actual"}
+
+	err := newValidator(t).ValidateStruct(context.Background(), value)
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(validation.ErrNotEqual).
+		WithPropertyPath("Value")
+}
@@ -3,6 +3,7 @@ package validate
 import (
 	"errors"
 	"net"
+	"net/netip"
 	"strconv"
 	"strings"
 )
@@ -118,6 +119,55 @@ func CIDR(value string, options ...func(*CIDROptions)) error {
 	return cidrCheckPrefixRange(prefix, ver, opts)
 }
 
+// ParseCIDR parses the CIDR notation (IP/prefix) into [netip.Prefix]. The address is kept as is
+// (use [netip.Prefix.Masked] to get the network address), IPv4-mapped IPv6 addresses are treated
+// as IPv4 addresses, the same way as [CIDR] does. It returns [ErrInvalidCIDR] for malformed notation,
+// invalid IP, or the prefix length out of the address bit length.
+func ParseCIDR(value string) (netip.Prefix, error) {
+	ipStr, bitsStr, err := cidrSplitNotation(value)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	bits, err := cidrParsePrefix(bitsStr)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	ip, err := netip.ParseAddr(ipStr)
+	if err != nil || ip.Zone() != "" {
+		return netip.Prefix{}, ErrInvalidCIDR
+	}
+
+	prefix := netip.PrefixFrom(ip.Unmap(), bits)
+	if !prefix.IsValid() {
+		return netip.Prefix{}, ErrInvalidCIDR
+	}
+
+	return prefix, nil
+}
+
+// PrefixWithin returns true if the prefix is entirely contained in any of the networks
+// (e.g. "10.1.0.0/16" is within "10.0.0.0/8", but "10.0.0.0/8" is not within "10.1.0.0/16").
+func PrefixWithin(prefix netip.Prefix, networks ...netip.Prefix) bool {
+	for _, network := range networks {
+		if network.Bits() <= prefix.Bits() && network.Contains(prefix.Addr()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// OverlappingPrefix returns the first of the networks having any addresses in common with the prefix.
+func OverlappingPrefix(prefix netip.Prefix, networks ...netip.Prefix) (netip.Prefix, bool) {
+	for _, network := range networks {
+		if network.Overlaps(prefix) {
+			return network, true
+		}
+	}
+
+	return netip.Prefix{}, false
+}
+
 func cidrCheckPrefixRange(prefix, ver int, opts CIDROptions) error {
 	maxMask := opts.netmaskMax
 	if ver == 4 && maxMask > 32 {
@@ -2,6 +2,7 @@ package validate
 
 import (
 	"errors"
+	"net/netip"
 	"strings"
 	"testing"
 )
@@ -129,3 +130,90 @@ func TestCIDRViolationNetmaskBounds(t *testing.T) {
 		})
 	}
 }
+
+func TestParseCIDR(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		value      string
+		wantPrefix string
+		wantErr    error
+	}{
+		{name: "ipv4", value: "10.1.2.0/24", wantPrefix: "10.1.2.0/24"},
+		{name: "ipv4 with host bits", value: "10.1.2.3/24", wantPrefix: "10.1.2.3/24"},
+		{name: "ipv6", value: "2001:db8::/32", wantPrefix: "2001:db8::/32"},
+		{name: "ipv4 mapped ipv6 as ipv4", value: "::ffff:10.0.0.0/8", wantPrefix: "10.0.0.0/8"},
+		{name: "leading zero in prefix", value: "10.0.0.0/08", wantPrefix: "10.0.0.0/8"},
+		{name: "no slash", value: "10.0.0.0", wantErr: ErrInvalidCIDR},
+		{name: "zone", value: "fe80::1%eth0/64", wantErr: ErrInvalidCIDR},
+		{name: "prefix too large ipv4", value: "10.0.0.0/33", wantErr: ErrInvalidCIDR},
+		{name: "prefix too large ipv6", value: "2001:db8::/129", wantErr: ErrInvalidCIDR},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			prefix, err := ParseCIDR(tt.value)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseCIDR(%q): got %v, want %v", tt.value, err, tt.wantErr)
+			}
+			if err == nil && prefix.String() != tt.wantPrefix {
+				t.Fatalf("ParseCIDR(%q): got %s, want %s", tt.value, prefix, tt.wantPrefix)
+			}
+		})
+	}
+}
+
+func TestPrefixWithin(t *testing.T) {
+	t.Parallel()
+
+	networks := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("2001:db8::/32")}
+	tests := []struct {
+		prefix string
+		want   bool
+	}{
+		{prefix: "10.0.0.0/8", want: true},
+		{prefix: "10.1.0.0/16", want: true},
+		{prefix: "10.255.255.255/32", want: true},
+		{prefix: "2001:db8:1::/48", want: true},
+		{prefix: "0.0.0.0/0", want: false},
+		{prefix: "11.0.0.0/16", want: false},
+		{prefix: "2001:db9::/48", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.prefix, func(t *testing.T) {
+			t.Parallel()
+			if got := PrefixWithin(netip.MustParsePrefix(tt.prefix), networks...); got != tt.want {
+				t.Fatalf("PrefixWithin(%s): got %v, want %v", tt.prefix, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOverlappingPrefix(t *testing.T) {
+	t.Parallel()
+
+	networks := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/16"), netip.MustParsePrefix("192.168.0.0/24")}
+	tests := []struct {
+		prefix      string
+		wantNetwork string
+	}{
+		{prefix: "10.0.128.0/17", wantNetwork: "10.0.0.0/16"},
+		{prefix: "10.0.0.0/8", wantNetwork: "10.0.0.0/16"},
+		{prefix: "192.168.0.128/25", wantNetwork: "192.168.0.0/24"},
+		{prefix: "10.1.0.0/16"},
+		{prefix: "2001:db8::/32"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.prefix, func(t *testing.T) {
+			t.Parallel()
+			network, ok := OverlappingPrefix(netip.MustParsePrefix(tt.prefix), networks...)
+			if ok != (tt.wantNetwork != "") || (ok && network.String() != tt.wantNetwork) {
+				t.Fatalf("OverlappingPrefix(%s): got %s, %v, want %s", tt.prefix, network, ok, tt.wantNetwork)
+			}
+		})
+	}
+}
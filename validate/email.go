@@ -0,0 +1,384 @@
+package validate
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	// ErrInvalidEmailLocalPart is returned by [Email] when the local part (before the "@" symbol)
+	// of the email address is not valid.
+	ErrInvalidEmailLocalPart = fmt.Errorf("%w: email local part", ErrInvalid)
+	// ErrInvalidEmailDomain is returned by [Email] when the domain part (after the "@" symbol)
+	// of the email address is not valid.
+	ErrInvalidEmailDomain = fmt.Errorf("%w: email domain", ErrInvalid)
+)
+
+const (
+	maxHostnameLength      = 253
+	maxHostnameLabelLength = 63
+)
+
+// EmailOptions configures [Email] validation.
+type EmailOptions struct {
+	allowsComments  bool
+	allowsUTF8      bool
+	deniesIPLiteral bool
+}
+
+// EmailAllowComments makes [Email] accept comments and folding white space around the local part
+// and the domain (e.g. "(work) user@example.com"). By default, comments are rejected, because
+// they are removed by mail servers and are not expected in user input.
+func EmailAllowComments() func(*EmailOptions) {
+	return func(o *EmailOptions) {
+		o.allowsComments = true
+	}
+}
+
+// EmailAllowUTF8 makes [Email] accept internationalized email addresses according to RFC 6531:
+// the local part may contain UTF-8 characters, and the domain is validated by [IDNHostname]
+// (e.g. "пользователь@пример.рф").
+func EmailAllowUTF8() func(*EmailOptions) {
+	return func(o *EmailOptions) {
+		o.allowsUTF8 = true
+	}
+}
+
+// EmailDenyIPLiteral makes [Email] reject IP address literals as the domain
+// (e.g. "user@[192.0.2.1]" or "user@[IPv6:2001:db8::1]").
+func EmailDenyIPLiteral() func(*EmailOptions) {
+	return func(o *EmailOptions) {
+		o.deniesIPLiteral = true
+	}
+}
+
+// Email validates that the value is a valid email address according to the addr-spec grammar
+// of RFC 5322 without the obsolete syntax. It checks that:
+//   - the local part is a dot-atom ("first.last") or a quoted string ("\"john doe\"");
+//   - the local part may be no more than 64 octets long;
+//   - the domain is a fully qualified hostname, each label of which consists of letters, digits,
+//     and hyphens and may be no more than 63 octets long, or an IP address literal
+//     ("[192.0.2.1]" or "[IPv6:2001:db8::1]");
+//   - the total length of the address must not exceed 254 characters.
+//
+// Use [EmailAllowComments], [EmailAllowUTF8], and [EmailDenyIPLiteral] to configure the validation.
+//
+// If value is not valid the function will return one of the errors:
+//   - [ErrInvalidEmailLocalPart] on invalid local part;
+//   - [ErrInvalidEmailDomain] on invalid domain;
+//   - [ErrInvalid] if the value has no "@" symbol or the address is too long.
+func Email(value string, options ...func(*EmailOptions)) error {
+	opts := EmailOptions{}
+	for _, option := range options {
+		option(&opts)
+	}
+
+	p := emailParser{value: value, options: opts}
+	local, ok := p.parseLocalPart()
+	if !ok {
+		if !strings.Contains(value, "@") {
+			return ErrInvalid
+		}
+		return ErrInvalidEmailLocalPart
+	}
+	if len(local) > maxEmailLocalPart {
+		return ErrInvalidEmailLocalPart
+	}
+
+	domain, ok := p.parseDomain()
+	if !ok {
+		return ErrInvalidEmailDomain
+	}
+	if len(local)+1+len(domain) > maxEmailLength {
+		return ErrInvalid
+	}
+
+	return nil
+}
+
+type emailParser struct {
+	value    string
+	position int
+	options  EmailOptions
+}
+
+// parseLocalPart returns the local part without the surrounding comments
+// and moves the position after the "@" symbol.
+func (p *emailParser) parseLocalPart() (string, bool) {
+	if !p.skipCFWS() {
+		return "", false
+	}
+
+	start := p.position
+	var ok bool
+	if p.peek() == '"' {
+		ok = p.parseQuotedString()
+	} else {
+		ok = p.parseDotAtom()
+	}
+	if !ok {
+		return "", false
+	}
+	local := p.value[start:p.position]
+
+	if !p.skipCFWS() || p.peek() != '@' {
+		return "", false
+	}
+	p.position++
+
+	return local, true
+}
+
+// parseDomain returns the domain without the surrounding comments in the ASCII form.
+func (p *emailParser) parseDomain() (string, bool) {
+	if !p.skipCFWS() {
+		return "", false
+	}
+
+	start := p.position
+	var domain string
+	if p.peek() == '[' {
+		if p.options.deniesIPLiteral || !p.parseDomainLiteral() {
+			return "", false
+		}
+		domain = p.value[start:p.position]
+	} else {
+		for p.position < len(p.value) && !isEmailDomainDelimiter(p.value[p.position]) {
+			p.position++
+		}
+		var ok bool
+		domain, ok = p.normalizeHostname(p.value[start:p.position])
+		if !ok {
+			return "", false
+		}
+	}
+
+	if !p.skipCFWS() || p.position != len(p.value) {
+		return "", false
+	}
+
+	return domain, true
+}
+
+func (p *emailParser) normalizeHostname(hostname string) (string, bool) {
+	if p.options.allowsUTF8 {
+		ascii, err := idnHostname(hostname, IDNOptions{})
+		return ascii, err == nil && strings.Contains(ascii, ".")
+	}
+	if !isStrictEmailHostname(hostname) {
+		return "", false
+	}
+
+	return hostname, true
+}
+
+// parseDotAtom parses the dot-atom-text: atoms separated by single dots.
+func (p *emailParser) parseDotAtom() bool {
+	for {
+		start := p.position
+		for p.position < len(p.value) {
+			r, size := utf8.DecodeRuneInString(p.value[p.position:])
+			if !p.isAtext(r) {
+				break
+			}
+			p.position += size
+		}
+		if p.position == start {
+			return false
+		}
+		if p.peek() != '.' {
+			return true
+		}
+		p.position++
+	}
+}
+
+// parseQuotedString parses the quoted string with quoted pairs ("\"") and white space.
+func (p *emailParser) parseQuotedString() bool {
+	p.position++
+	for p.position < len(p.value) {
+		r, size := utf8.DecodeRuneInString(p.value[p.position:])
+		switch {
+		case r == '"':
+			p.position++
+			return true
+		case r == '\\':
+			p.position++
+			if !p.parseQuotedPair() {
+				return false
+			}
+		case r == ' ' || r == '\t' || p.isQtext(r):
+			p.position += size
+		default:
+			return false
+		}
+	}
+
+	return false
+}
+
+// parseDomainLiteral parses the IPv4 address literal ("[192.0.2.1]")
+// or the IPv6 address literal ("[IPv6:2001:db8::1]").
+func (p *emailParser) parseDomainLiteral() bool {
+	end := strings.IndexByte(p.value[p.position:], ']')
+	if end < 0 {
+		return false
+	}
+	literal := p.value[p.position+1 : p.position+end]
+	p.position += end + 1
+
+	if ipv6, ok := strings.CutPrefix(literal, "IPv6:"); ok {
+		ip, err := netip.ParseAddr(ipv6)
+		return err == nil && ip.Is6() && ip.Zone() == ""
+	}
+	ip, err := netip.ParseAddr(literal)
+
+	return err == nil && ip.Is4()
+}
+
+// skipCFWS skips the comments and the white space if the comments are allowed.
+// It returns false on unterminated comment.
+func (p *emailParser) skipCFWS() bool {
+	if !p.options.allowsComments {
+		return true
+	}
+
+	for p.position < len(p.value) {
+		switch p.value[p.position] {
+		case ' ', '\t':
+			p.position++
+		case '(':
+			if !p.skipComment() {
+				return false
+			}
+		default:
+			return true
+		}
+	}
+
+	return true
+}
+
+// skipComment skips the comment that may contain nested comments and quoted pairs.
+func (p *emailParser) skipComment() bool {
+	depth := 0
+	for p.position < len(p.value) {
+		r, size := utf8.DecodeRuneInString(p.value[p.position:])
+		switch {
+		case r == '(':
+			depth++
+			p.position++
+		case r == ')':
+			depth--
+			p.position++
+			if depth == 0 {
+				return true
+			}
+		case r == '\\':
+			p.position++
+			if !p.parseQuotedPair() {
+				return false
+			}
+		case r == ' ' || r == '\t' || p.isCtext(r):
+			p.position += size
+		default:
+			return false
+		}
+	}
+
+	return false
+}
+
+// parseQuotedPair parses the character after the backslash.
+func (p *emailParser) parseQuotedPair() bool {
+	r, size := utf8.DecodeRuneInString(p.value[p.position:])
+	if r != ' ' && r != '\t' && !isVisibleASCII(r) && !p.isUTF8NonASCII(r) {
+		return false
+	}
+	p.position += size
+
+	return true
+}
+
+func (p *emailParser) peek() byte {
+	if p.position < len(p.value) {
+		return p.value[p.position]
+	}
+
+	return 0
+}
+
+func (p *emailParser) isAtext(r rune) bool {
+	if r < utf8.RuneSelf {
+		return isASCIILetterOrDigit(r) || strings.ContainsRune(emailSpecialAtext, r)
+	}
+
+	return p.isUTF8NonASCII(r)
+}
+
+func (p *emailParser) isQtext(r rune) bool {
+	if r < utf8.RuneSelf {
+		return isVisibleASCII(r) && r != '"' && r != '\\'
+	}
+
+	return p.isUTF8NonASCII(r)
+}
+
+func (p *emailParser) isCtext(r rune) bool {
+	if r < utf8.RuneSelf {
+		return isVisibleASCII(r) && r != '(' && r != ')' && r != '\\'
+	}
+
+	return p.isUTF8NonASCII(r)
+}
+
+func (p *emailParser) isUTF8NonASCII(r rune) bool {
+	return p.options.allowsUTF8 && isIDNAtext(r)
+}
+
+func isVisibleASCII(r rune) bool {
+	return r >= '!' && r <= '~'
+}
+
+func isEmailDomainDelimiter(c byte) bool {
+	return c == ' ' || c == '\t' || c == '('
+}
+
+// isStrictEmailHostname checks that the hostname consists of at least two labels
+// of letters, digits, and hyphens that may be no more than 63 octets long.
+func isStrictEmailHostname(hostname string) bool {
+	if len(hostname) > maxHostnameLength {
+		return false
+	}
+
+	labels := strings.Split(hostname, ".")
+	if len(labels) < 2 {
+		return false
+	}
+	for _, label := range labels {
+		if !isHostnameLabel(label) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isHostnameLabel(label string) bool {
+	if label == "" || len(label) > maxHostnameLabelLength || label[0] == '-' || label[len(label)-1] == '-' {
+		return false
+	}
+	for _, c := range label {
+		if c != '-' && !isASCIILetterOrDigit(c) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isASCIILetterOrDigit(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9'
+}
@@ -0,0 +1,170 @@
+package validate_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/muonsoft/validation/validate"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmail_WhenValidEmail_ExpectNoError(t *testing.T) {
+	emails := []string{
+		"user@example.com",
+		"first.last@example.com",
+		"user+tag@sub.example.co.uk",
+		"{}~!#$%&'*+-/=?^_`|@example.com",
+		`"john doe"@example.com`,
+		`"john@doe"@example.com`,
+		`"john\"doe"@example.com`,
+		`"john\\doe"@example.com`,
+		`""@example.com`,
+		"user@[192.0.2.1]",
+		"user@[IPv6:2001:db8::1]",
+		"user@xn--e1afmkfd.xn--p1ai",
+		"user@EXAMPLE.COM",
+		strings.Repeat("a", 64) + "@example.com",
+	}
+	for _, email := range emails {
+		t.Run(email, func(t *testing.T) {
+			err := validate.Email(email)
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestEmail_WhenInvalidLocalPart_ExpectLocalPartError(t *testing.T) {
+	emails := []string{
+		"@example.com",
+		".user@example.com",
+		"user.@example.com",
+		"us..er@example.com",
+		"user name@example.com",
+		"user(comment)@example.com",
+		"(comment)user@example.com",
+		`"unterminated@example.com`,
+		`"john"doe@example.com`,
+		"\"john\ndoe\"@example.com",
+		"пользователь@example.com",
+		"user\x00@example.com",
+		strings.Repeat("a", 65) + "@example.com",
+	}
+	for _, email := range emails {
+		t.Run(email, func(t *testing.T) {
+			err := validate.Email(email)
+
+			assert.ErrorIs(t, err, validate.ErrInvalidEmailLocalPart)
+			assert.ErrorIs(t, err, validate.ErrInvalid)
+		})
+	}
+}
+
+func TestEmail_WhenInvalidDomain_ExpectDomainError(t *testing.T) {
+	emails := []string{
+		"user@",
+		"user@localhost",
+		"user@example",
+		"user@.example.com",
+		"user@example..com",
+		"user@example.com.",
+		"user@-example.com",
+		"user@example-.com",
+		"user@exa_mple.com",
+		"user@example.com (comment)",
+		"user@a@example.com",
+		"user@пример.рф",
+		"user@[192.0.2.256]",
+		"user@[2001:db8::1]",
+		"user@[IPv6:192.0.2.1]",
+		"user@[IPv6:fe80::1%eth0]",
+		"user@[192.0.2.1",
+		"user@[192.0.2.1]x",
+		"user@" + strings.Repeat("a", 64) + ".com",
+	}
+	for _, email := range emails {
+		t.Run(email, func(t *testing.T) {
+			err := validate.Email(email)
+
+			assert.ErrorIs(t, err, validate.ErrInvalidEmailDomain)
+		})
+	}
+}
+
+func TestEmail_WhenInvalidEmail_ExpectGenericError(t *testing.T) {
+	emails := []string{
+		"",
+		"user",
+		"user.example.com",
+		strings.Repeat("a", 64) + "@" + strings.Repeat("b.", 94) + "com",
+	}
+	for _, email := range emails {
+		t.Run(email, func(t *testing.T) {
+			err := validate.Email(email)
+
+			assert.ErrorIs(t, err, validate.ErrInvalid)
+			assert.NotErrorIs(t, err, validate.ErrInvalidEmailLocalPart)
+			assert.NotErrorIs(t, err, validate.ErrInvalidEmailDomain)
+		})
+	}
+}
+
+func TestEmail_WhenCommentsAllowed_ExpectCommentsAccepted(t *testing.T) {
+	tests := []struct {
+		email       string
+		expectedErr error
+	}{
+		{email: "(comment)user@example.com"},
+		{email: "user(comment)@example.com"},
+		{email: "user@(comment)example.com"},
+		{email: "user@example.com(comment)"},
+		{email: " user @ example.com "},
+		{email: "user@example.com (nested (comment) with \\) escaped)"},
+		{email: "(unterminated user@example.com", expectedErr: validate.ErrInvalidEmailLocalPart},
+		{email: "user@example.com (unterminated", expectedErr: validate.ErrInvalidEmailDomain},
+		{email: "user(comment)name@example.com", expectedErr: validate.ErrInvalidEmailLocalPart},
+	}
+	for _, test := range tests {
+		t.Run(test.email, func(t *testing.T) {
+			err := validate.Email(test.email, validate.EmailAllowComments())
+
+			if test.expectedErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, test.expectedErr)
+			}
+		})
+	}
+}
+
+func TestEmail_WhenUTF8Allowed_ExpectInternationalizedEmailAccepted(t *testing.T) {
+	tests := []struct {
+		email       string
+		expectedErr error
+	}{
+		{email: "пользователь@пример.рф"},
+		{email: `"имя пользователя"@пример.рф`},
+		{email: "用户@例子.广告"},
+		{email: "user@example.com"},
+		{email: "user\u0085@example.com", expectedErr: validate.ErrInvalidEmailLocalPart},
+		{email: "пользователь@пример", expectedErr: validate.ErrInvalidEmailDomain},
+		{email: "пользователь@пример-.рф", expectedErr: validate.ErrInvalidEmailDomain},
+	}
+	for _, test := range tests {
+		t.Run(test.email, func(t *testing.T) {
+			err := validate.Email(test.email, validate.EmailAllowUTF8())
+
+			if test.expectedErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, test.expectedErr)
+			}
+		})
+	}
+}
+
+func TestEmail_WhenIPLiteralDenied_ExpectDomainError(t *testing.T) {
+	err := validate.Email("user@[192.0.2.1]", validate.EmailDenyIPLiteral())
+
+	assert.ErrorIs(t, err, validate.ErrInvalidEmailDomain)
+}
@@ -94,6 +94,20 @@ func ExampleURL() {
 	// <nil>
 }
 
+func ExampleDenyInternalURLTargets() {
+	fmt.Println(validate.URL("https://example.com/webhook", validate.DenyInternalURLTargets()))   // public host
+	fmt.Println(validate.URL("http://169.254.169.254/latest", validate.DenyInternalURLTargets())) // metadata endpoint
+	fmt.Println(validate.URL("http://0x7f.1/", validate.DenyInternalURLTargets()))                // hexadecimal loopback IP
+	fmt.Println(validate.URL("http://[::ffff:10.0.0.1]/", validate.DenyInternalURLTargets()))     // IPv4-mapped private IP
+	fmt.Println(validate.URL("http://example.com@localhost/", validate.DenyInternalURLTargets())) // user information
+	// Output:
+	// <nil>
+	// prohibited: internal target
+	// prohibited: internal target
+	// prohibited: internal target
+	// prohibited: user information in URL
+}
+
 func ExampleIP() {
 	fmt.Println(validate.IP("123.123.123.123"))                         // valid IPv4
 	fmt.Println(validate.IP("2001:0db8:85a3:0000:0000:8a2e:0370:7334")) // valid IPv6
@@ -273,3 +287,21 @@ func ExampleNoSuspiciousCharacters() {
 	// suspicious mixed digit scripts
 	// <nil>
 }
+
+func ExampleIDNHostname() {
+	fmt.Println(validate.IDNHostname("пример.рф"))
+	fmt.Println(validate.IDNHostname("пример", validate.IDNRequireTLD()))
+	fmt.Println(validate.IDNHostname("pаypal.com", validate.IDNDenyMixedScripts())) // Cyrillic "а"
+	// Output:
+	// xn--e1afmkfd.xn--p1ai <nil>
+	//  invalid
+	//  suspicious script restriction
+}
+
+func ExampleIDNEmail() {
+	fmt.Println(validate.IDNEmail("пользователь@пример.рф"))
+	fmt.Println(validate.IDNEmail("user..name@пример.рф"))
+	// Output:
+	// пользователь@xn--e1afmkfd.xn--p1ai <nil>
+	//  invalid
+}
@@ -0,0 +1,196 @@
+package validate
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+)
+
+// IDNOptions configures [IDNHostname] and [IDNEmail] validation.
+type IDNOptions struct {
+	requiresTLD       bool
+	denyMixedScripts  bool
+	suspiciousOptions []NoSuspiciousCharactersOption
+}
+
+// IDNRequireTLD makes [IDNHostname] check that the hostname is fully qualified and includes
+// its top-level domain name (for instance, "пример.рф" is valid but "пример" is not).
+// Also, it checks for reserved top-level domains according to RFC 2606:
+// .example, .invalid, .localhost, and .test.
+func IDNRequireTLD() func(*IDNOptions) {
+	return func(o *IDNOptions) {
+		o.requiresTLD = true
+	}
+}
+
+// IDNDenyMixedScripts enables the check of each label of the domain by [NoSuspiciousCharacters]
+// with the [SuspiciousRestrictionHigh] restriction to reject homograph domains mixing
+// the scripts within the label, for example, "pаypal.com" with the Cyrillic "а". Labels using
+// different scripts (e.g. "пример.com") and labels combining the CJK scripts allowed
+// by Unicode Technical Standard #39 (e.g. "例え.テスト" mixing Han and Hiragana) are valid. You can pass options to override the restriction
+// or the checks, for example, [WithSuspiciousLocales] with [SuspiciousRestrictionLocales].
+//
+// Note that the whole-script homographs (all letters of the label are from a single script that look like
+// letters from another script) are not detected by this check.
+func IDNDenyMixedScripts(options ...NoSuspiciousCharactersOption) func(*IDNOptions) {
+	return func(o *IDNOptions) {
+		o.denyMixedScripts = true
+		o.suspiciousOptions = options
+	}
+}
+
+// IDNHostname validates that the value is a valid internationalized hostname according to
+// IDNA 2008 and Unicode Technical Standard #46 (the lookup profile). The value may contain Unicode labels
+// (e.g. "пример.рф") or ASCII-compatible "xn--" labels. The hostname is returned in ASCII-compatible
+// (punycode) form, normalized to lower case (e.g. "xn--e1afmkfd.xn--p1ai"), so it can be stored or
+// compared with other hostnames.
+//
+// It checks that each label consists of the allowed characters and may be no more than 63 octets long,
+// and the total length of the ASCII form does not exceed 253 characters.
+//
+// If value is not valid the function will return one of the errors:
+//   - [ErrInvalid] on invalid hostname;
+//   - [ErrSuspiciousInvisible], [ErrSuspiciousMixedNumbers], [ErrSuspiciousHiddenOverlay],
+//     or [ErrSuspiciousRestriction] on suspicious label if [IDNDenyMixedScripts] option is used.
+func IDNHostname(value string, options ...func(*IDNOptions)) (string, error) {
+	opts := IDNOptions{}
+	for _, option := range options {
+		option(&opts)
+	}
+
+	return idnHostname(value, opts)
+}
+
+// IDNEmail validates that the value is a valid internationalized email address (RFC 6531, SMTPUTF8).
+// The local part is a dot-atom that may contain UTF-8 characters and may be no more than 64 octets long.
+// The domain part is validated by [IDNHostname] and must contain at least two labels.
+// The email is returned with the domain in ASCII-compatible (punycode) form, the local part is kept as is
+// (e.g. "пользователь@xn--e1afmkfd.xn--p1ai" for "пользователь@пример.рф").
+//
+// If value is not valid the function will return one of the errors:
+//   - [ErrInvalid] on invalid email address;
+//   - [ErrSuspiciousInvisible], [ErrSuspiciousMixedNumbers], [ErrSuspiciousHiddenOverlay],
+//     or [ErrSuspiciousRestriction] on suspicious domain label if [IDNDenyMixedScripts] option is used.
+func IDNEmail(value string, options ...func(*IDNOptions)) (string, error) {
+	opts := IDNOptions{}
+	for _, option := range options {
+		option(&opts)
+	}
+
+	at := strings.LastIndexByte(value, '@')
+	if at < 0 || !isIDNLocalPart(value[:at]) {
+		return "", ErrInvalid
+	}
+	domain, err := idnHostname(value[at+1:], opts)
+	if err != nil {
+		return "", err
+	}
+	if !strings.Contains(domain, ".") {
+		return "", ErrInvalid
+	}
+
+	email := value[:at] + "@" + domain
+	if len(email) > maxEmailLength {
+		return "", ErrInvalid
+	}
+
+	return email, nil
+}
+
+const (
+	maxEmailLength    = 254
+	maxEmailLocalPart = 64
+	emailSpecialAtext = "!#$%&'*+-/=?^_`{|}~"
+)
+
+// reservedTopLevelDomains are reserved by RFC 2606.
+var reservedTopLevelDomains = []string{
+	"example",
+	"invalid",
+	"localhost",
+	"test",
+}
+
+var idnaLookupProfile = idna.New(
+	idna.MapForLookup(),
+	idna.BidiRule(),
+	idna.VerifyDNSLength(true),
+	idna.Transitional(false),
+)
+
+func idnHostname(value string, opts IDNOptions) (string, error) {
+	if value == "" || strings.HasSuffix(value, ".") {
+		return "", ErrInvalid
+	}
+	ascii, err := idnaLookupProfile.ToASCII(value)
+	if err != nil {
+		return "", ErrInvalid
+	}
+
+	labels := strings.Split(ascii, ".")
+	if opts.requiresTLD {
+		if len(labels) < 2 || slices.Contains(reservedTopLevelDomains, labels[len(labels)-1]) {
+			return "", ErrInvalid
+		}
+	}
+	if opts.denyMixedScripts {
+		if err := checkIDNLabels(labels, opts.suspiciousOptions); err != nil {
+			return "", err
+		}
+	}
+
+	return ascii, nil
+}
+
+func checkIDNLabels(labels []string, options []NoSuspiciousCharactersOption) error {
+	options = append([]NoSuspiciousCharactersOption{
+		WithSuspiciousRestriction(SuspiciousRestrictionHigh),
+	}, options...)
+
+	for _, label := range labels {
+		unicodeLabel, err := idnaLookupProfile.ToUnicode(label)
+		if err != nil {
+			return ErrInvalid
+		}
+		if err := NoSuspiciousCharacters(unicodeLabel, options...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isIDNLocalPart checks that the local part is a dot-atom with the atext extended
+// by the non-ASCII UTF-8 characters (RFC 6531, section 3.3).
+func isIDNLocalPart(local string) bool {
+	if local == "" || len(local) > maxEmailLocalPart || !utf8.ValidString(local) {
+		return false
+	}
+
+	for _, atom := range strings.Split(local, ".") {
+		if atom == "" {
+			return false
+		}
+		for _, r := range atom {
+			if !isIDNAtext(r) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func isIDNAtext(r rune) bool {
+	switch {
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		return true
+	case r < utf8.RuneSelf:
+		return strings.ContainsRune(emailSpecialAtext, r)
+	default:
+		// C1 control characters are not allowed
+		return r >= 0xA0 && r != utf8.RuneError
+	}
+}
@@ -0,0 +1,182 @@
+package validate_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/muonsoft/validation/validate"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIDNHostname_WhenValidHostname_ExpectASCIIForm(t *testing.T) {
+	tests := []struct {
+		hostname string
+		expected string
+	}{
+		{hostname: "example.com", expected: "example.com"},
+		{hostname: "EXAMPLE.com", expected: "example.com"},
+		{hostname: "пример.рф", expected: "xn--e1afmkfd.xn--p1ai"},
+		{hostname: "ПРИМЕР.РФ", expected: "xn--e1afmkfd.xn--p1ai"},
+		{hostname: "xn--e1afmkfd.xn--p1ai", expected: "xn--e1afmkfd.xn--p1ai"},
+		{hostname: "пример。рф", expected: "xn--e1afmkfd.xn--p1ai"},
+		{hostname: "bücher.de", expected: "xn--bcher-kva.de"},
+		{hostname: "faß.de", expected: "xn--fa-hia.de"},
+		{hostname: "例え.テスト.jp", expected: "xn--r8jz45g.xn--zckzah.jp"},
+		{hostname: "localhost", expected: "localhost"},
+	}
+	for _, test := range tests {
+		t.Run(test.hostname, func(t *testing.T) {
+			hostname, err := validate.IDNHostname(test.hostname)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, hostname)
+		})
+	}
+}
+
+func TestIDNHostname_WhenInvalidHostname_ExpectError(t *testing.T) {
+	hostnames := []string{
+		"",
+		"example.com.",
+		"-example.com",
+		"example-.com",
+		"exa mple.com",
+		"example..com",
+		"xn--zz.com",
+		"пример_.рф",
+		strings.Repeat("ж", 64) + ".рф",
+		strings.Repeat("a.", 127) + "com",
+	}
+	for _, hostname := range hostnames {
+		t.Run(hostname, func(t *testing.T) {
+			_, err := validate.IDNHostname(hostname)
+
+			assert.ErrorIs(t, err, validate.ErrInvalid)
+		})
+	}
+}
+
+func TestIDNHostname_WhenRequireTLD_ExpectFullyQualifiedHostname(t *testing.T) {
+	tests := []struct {
+		hostname string
+		isValid  bool
+	}{
+		{hostname: "пример.рф", isValid: true},
+		{hostname: "пример", isValid: false},
+		{hostname: "пример.test", isValid: false},
+		{hostname: "example.localhost", isValid: false},
+		{hostname: "пример.EXAMPLE", isValid: false},
+	}
+	for _, test := range tests {
+		t.Run(test.hostname, func(t *testing.T) {
+			_, err := validate.IDNHostname(test.hostname, validate.IDNRequireTLD())
+
+			if test.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, validate.ErrInvalid)
+			}
+		})
+	}
+}
+
+func TestIDNHostname_WhenDenyMixedScripts_ExpectHomographRejected(t *testing.T) {
+	tests := []struct {
+		hostname    string
+		expectedErr error
+	}{
+		{hostname: "example.com"},
+		{hostname: "пример.рф"},
+		{hostname: "пример.com"},
+		{hostname: "例え.テスト"},
+		{hostname: "例え.テスト.jp"},
+		{hostname: "中文ㄅㄆ.tw"},
+		{hostname: "한국語.kr"},
+		{hostname: "pаypal.com", expectedErr: validate.ErrSuspiciousRestriction},
+		{hostname: "例えпример.com", expectedErr: validate.ErrSuspiciousRestriction},
+		{hostname: "한국テスト.com", expectedErr: validate.ErrSuspiciousRestriction},
+		{hostname: "xn--pypal-4ve.com", expectedErr: validate.ErrSuspiciousRestriction},
+		{hostname: "аpple.рф", expectedErr: validate.ErrSuspiciousRestriction},
+	}
+	for _, test := range tests {
+		t.Run(test.hostname, func(t *testing.T) {
+			_, err := validate.IDNHostname(test.hostname, validate.IDNDenyMixedScripts())
+
+			if test.expectedErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, test.expectedErr)
+			}
+		})
+	}
+}
+
+func TestIDNHostname_WhenDenyMixedScriptsWithLocales_ExpectLocaleScriptsAllowed(t *testing.T) {
+	options := validate.IDNDenyMixedScripts(
+		validate.WithSuspiciousRestriction(validate.SuspiciousRestrictionLocales),
+		validate.WithSuspiciousLocales("ja"),
+	)
+
+	_, err := validate.IDNHostname("例え.テスト.jp", options)
+
+	assert.NoError(t, err)
+}
+
+func TestIDNHostname_WhenMixedScriptsNotDenied_ExpectNoError(t *testing.T) {
+	hostname, err := validate.IDNHostname("pаypal.com")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "xn--pypal-4ve.com", hostname)
+}
+
+func TestIDNEmail_WhenValidEmail_ExpectASCIIDomain(t *testing.T) {
+	tests := []struct {
+		email    string
+		expected string
+	}{
+		{email: "user@example.com", expected: "user@example.com"},
+		{email: "first.last+tag@Example.COM", expected: "first.last+tag@example.com"},
+		{email: "пользователь@пример.рф", expected: "пользователь@xn--e1afmkfd.xn--p1ai"},
+		{email: "用户@例子.广告", expected: "用户@xn--fsqu00a.xn--4rr70v"},
+		{email: "{}~!@example.com", expected: "{}~!@example.com"},
+	}
+	for _, test := range tests {
+		t.Run(test.email, func(t *testing.T) {
+			email, err := validate.IDNEmail(test.email)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, email)
+		})
+	}
+}
+
+func TestIDNEmail_WhenInvalidEmail_ExpectError(t *testing.T) {
+	emails := []string{
+		"",
+		"user",
+		"user@",
+		"@example.com",
+		"user@localhost",
+		"user name@example.com",
+		".user@example.com",
+		"user.@example.com",
+		"us..er@example.com",
+		"user@exa mple.com",
+		"user\u0085@example.com",
+		strings.Repeat("a", 65) + "@example.com",
+		strings.Repeat("u", 10) + "@" + strings.Repeat("a.", 124) + "com",
+	}
+	for _, email := range emails {
+		t.Run(email, func(t *testing.T) {
+			_, err := validate.IDNEmail(email)
+
+			assert.ErrorIs(t, err, validate.ErrInvalid)
+		})
+	}
+}
+
+func TestIDNEmail_WhenDenyMixedScriptsAndHomographDomain_ExpectError(t *testing.T) {
+	_, err := validate.IDNEmail("user@pаypal.com", validate.IDNDenyMixedScripts())
+
+	assert.ErrorIs(t, err, validate.ErrSuspiciousRestriction)
+}
@@ -2,6 +2,7 @@ package validate
 
 import (
 	"errors"
+	"slices"
 	"strings"
 	"unicode"
 	"unicode/utf8"
@@ -37,6 +38,10 @@ const (
 	SuspiciousRestrictionLocales
 	// SuspiciousRestrictionSingleScript requires at most one non-Common, non-Inherited script among letters/marks/numbers.
 	SuspiciousRestrictionSingleScript
+	// SuspiciousRestrictionHigh works as [SuspiciousRestrictionSingleScript], but also allows the script combinations
+	// of Unicode Technical Standard #39 (like RESTRICTION_LEVEL_HIGH): Latin, Han, Hiragana, and Katakana (Japanese);
+	// Latin, Han, and Bopomofo (Chinese); Latin, Han, and Hangul (Korean).
+	SuspiciousRestrictionHigh
 )
 
 var (
@@ -144,6 +149,8 @@ func applySuspiciousRestriction(rs []rune, r SuspiciousRestriction, locales []st
 		return checkSuspiciousLocales(rs, loc)
 	case SuspiciousRestrictionSingleScript:
 		return checkSuspiciousSingleScript(rs)
+	case SuspiciousRestrictionHigh:
+		return checkSuspiciousHighlyRestrictive(rs)
 	default:
 		return nil
 	}
@@ -350,6 +357,46 @@ func checkSuspiciousSingleScript(runes []rune) error {
 	return nil
 }
 
+// highlyRestrictiveScriptSets are the sets of scripts that can be mixed according to
+// the "Highly Restrictive" level of Unicode Technical Standard #39, section 5.2.
+var highlyRestrictiveScriptSets = [][]string{
+	{"Latin", "Han", "Hiragana", "Katakana"},
+	{"Latin", "Han", "Bopomofo"},
+	{"Latin", "Han", "Hangul"},
+}
+
+func checkSuspiciousHighlyRestrictive(runes []rune) error {
+	var scripts []string
+	for _, r := range runes {
+		if !needsScriptCheck(r) && !unicode.Is(unicode.Nd, r) {
+			continue
+		}
+		scr := scriptNameForRune(r)
+		if scr == "" || scr == unicodeScriptCommon || scr == unicodeScriptInherited || slices.Contains(scripts, scr) {
+			continue
+		}
+		scripts = append(scripts, scr)
+	}
+	if len(scripts) <= 1 {
+		return nil
+	}
+	for _, set := range highlyRestrictiveScriptSets {
+		if isSubsetOf(scripts, set) {
+			return nil
+		}
+	}
+	return ErrSuspiciousRestriction
+}
+
+func isSubsetOf(values, set []string) bool {
+	for _, value := range values {
+		if !slices.Contains(set, value) {
+			return false
+		}
+	}
+	return true
+}
+
 func needsScriptCheck(r rune) bool {
 	if unicode.IsLetter(r) {
 		return true
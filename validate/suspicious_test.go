@@ -44,6 +44,20 @@ func TestNoSuspiciousCharacters_singleScript(t *testing.T) {
 	)
 }
 
+func TestNoSuspiciousCharacters_high(t *testing.T) {
+	t.Parallel()
+	for _, value := range []string{"hello", "пример", "例え", "テスト", "東京abc", "中文ㄅㄆ", "한국語"} {
+		assert.NoError(t, NoSuspiciousCharacters(value, WithSuspiciousRestriction(SuspiciousRestrictionHigh)), value)
+	}
+	for _, value := range []string{"a\u0430", "例えпример", "한국テスト", "ㄅㄆ한국"} {
+		assert.ErrorIs(t,
+			NoSuspiciousCharacters(value, WithSuspiciousRestriction(SuspiciousRestrictionHigh)),
+			ErrSuspiciousRestriction,
+			value,
+		)
+	}
+}
+
 func TestNoSuspiciousCharacters_locales(t *testing.T) {
 	t.Parallel()
 	assert.NoError(t, NoSuspiciousCharacters("hello", WithSuspiciousRestriction(SuspiciousRestrictionLocales), WithSuspiciousLocales("en")))
@@ -2,10 +2,9 @@ package validate
 
 import (
 	"errors"
-	"net"
+	"net/netip"
 	"net/url"
 	"regexp"
-	"strings"
 )
 
 var (
@@ -86,15 +85,21 @@ func RestrictURLHostByPattern(pattern *regexp.Regexp) func(u *url.URL) error {
 	}
 }
 
-// IP validates that a value is a valid IP address (IPv4 or IPv6). You can use a list
+// IP validates that a value is a valid IP address (IPv4 or IPv6). IPv6 addresses with a zone
+// (e.g. "fe80::1%eth0") are invalid, use [IPAddrWithZone] to accept them. You can use a list
 // of restrictions to additionally check for a restricted range of IPs. For example,
 // you can deny using private IP addresses using [DenyPrivateIP] function.
 //
 // If value is not valid the function will return one of the errors:
 //   - [ErrInvalid] on invalid IP address;
 //   - [ErrProhibited] on restricted IP address.
-func IP(value string, restrictions ...func(ip net.IP) error) error {
-	return validateIP(value, restrictions...)
+func IP(value string, restrictions ...func(ip netip.Addr) error) error {
+	ip, err := netip.ParseAddr(value)
+	if err != nil {
+		return ErrInvalid
+	}
+
+	return IPAddr(ip, restrictions...)
 }
 
 // IPv4 validates that a value is a valid IPv4 address. You can use a list
@@ -104,42 +109,103 @@ func IP(value string, restrictions ...func(ip net.IP) error) error {
 // If value is not valid the function will return one of the errors:
 //   - [ErrInvalid] on invalid IP address or when using IPv6;
 //   - [ErrProhibited] on restricted IP address.
-func IPv4(value string, restrictions ...func(ip net.IP) error) error {
-	err := validateIP(value, restrictions...)
-	if err != nil {
-		return err
-	}
-	if !strings.Contains(value, ".") || strings.Contains(value, ":") {
+func IPv4(value string, restrictions ...func(ip netip.Addr) error) error {
+	ip, err := netip.ParseAddr(value)
+	if err != nil || !ip.Is4() {
 		return ErrInvalid
 	}
 
-	return nil
+	return IPAddr(ip, restrictions...)
 }
 
-// IPv6 validates that a value is a valid IPv6 address. You can use a list
+// IPv6 validates that a value is a valid IPv6 address (including IPv4-mapped addresses
+// like "::ffff:192.0.2.1"). Addresses with a zone (e.g. "fe80::1%eth0") are invalid,
+// use [IPAddrWithZone] to accept them. You can use a list
 // of restrictions to additionally check for a restricted range of IPs. For example,
 // you can deny using private IP addresses using [DenyPrivateIP] function.
 //
 // If value is not valid the function will return one of the errors:
 //   - [ErrInvalid] on invalid IP address or when using IPv4;
 //   - [ErrProhibited] on restricted IP address.
-func IPv6(value string, restrictions ...func(ip net.IP) error) error {
-	err := validateIP(value, restrictions...)
-	if err != nil {
-		return err
+func IPv6(value string, restrictions ...func(ip netip.Addr) error) error {
+	ip, err := netip.ParseAddr(value)
+	if err != nil || !ip.Is6() {
+		return ErrInvalid
+	}
+
+	return IPAddr(ip, restrictions...)
+}
+
+// IPAddr validates the already parsed IP address by the list of restrictions.
+// It returns [ErrInvalid] on the zero [netip.Addr] and on the IPv6 address with a zone
+// (use [IPAddrWithZone] to accept them). Otherwise, it returns the first error of the restrictions.
+func IPAddr(ip netip.Addr, restrictions ...func(ip netip.Addr) error) error {
+	if ip.Zone() != "" {
+		return ErrInvalid
 	}
-	if !strings.Contains(value, ":") {
+
+	return IPAddrWithZone(ip, restrictions...)
+}
+
+// IPAddrWithZone works exactly as [IPAddr], but it also accepts IPv6 addresses with a zone
+// (e.g. "fe80::1%eth0"). The zone makes sense only on the host it is defined on, so it is usually
+// not expected in the user input. The presets check the address without the zone.
+func IPAddrWithZone(ip netip.Addr, restrictions ...func(ip netip.Addr) error) error {
+	if !ip.IsValid() {
 		return ErrInvalid
 	}
+	for _, check := range restrictions {
+		if err := check(ip); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
 // DenyPrivateIP denies using of private IPs according to RFC 1918 (IPv4 addresses)
 // and RFC 4193 (IPv6 addresses).
-func DenyPrivateIP() func(ip net.IP) error {
-	return func(ip net.IP) error {
-		if ip.IsPrivate() {
+//
+// All the presets (DenyPrivateIP, [DenyLoopback], [DenyLinkLocal], [DenyMulticast], [DenyReserved])
+// check the address without the zone, and the IPv4-mapped IPv6 addresses are checked as IPv4 addresses.
+func DenyPrivateIP() func(ip netip.Addr) error {
+	return denyIP(netip.Addr.IsPrivate)
+}
+
+// DenyLoopback denies using of loopback IPs (127.0.0.0/8 and ::1).
+func DenyLoopback() func(ip netip.Addr) error {
+	return denyIP(netip.Addr.IsLoopback)
+}
+
+// DenyLinkLocal denies using of link-local unicast (169.254.0.0/16, fe80::/10)
+// and link-local multicast (224.0.0.0/24, ff02::/16) IPs.
+func DenyLinkLocal() func(ip netip.Addr) error {
+	return denyIP(func(ip netip.Addr) bool {
+		return ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast()
+	})
+}
+
+// DenyMulticast denies using of multicast IPs (224.0.0.0/4 and ff00::/8).
+func DenyMulticast() func(ip netip.Addr) error {
+	return denyIP(netip.Addr.IsMulticast)
+}
+
+// DenyReserved denies using of unspecified IPs (0.0.0.0 and ::) and the special-purpose IPs
+// from the RFC 6890 registries that are not covered by other presets: "this" network, shared address
+// space (CGNAT), documentation, benchmarking, IETF protocol assignments, reserved and broadcast IPv4 addresses,
+// deprecated IPv4-compatible and site-local IPv6 addresses, discard-only and local-use translation prefixes.
+func DenyReserved() func(ip netip.Addr) error {
+	return denyIP(isReservedIP)
+}
+
+// DenyPrefixes denies using of IPs contained in any of the given prefixes (e.g. "192.0.2.0/24").
+// The address is checked without the zone. IPv4-mapped IPv6 addresses and prefixes (e.g. "::ffff:10.0.0.0/104")
+// are checked as IPv4 ones, so "10.0.0.0/8" contains both "10.1.1.1" and "::ffff:10.1.1.1".
+func DenyPrefixes(prefixes ...netip.Prefix) func(ip netip.Addr) error {
+	prefixes = unmapPrefixes(prefixes)
+
+	return func(ip netip.Addr) error {
+		if containsIP(prefixes, ip) {
 			return ErrProhibited
 		}
 
@@ -147,18 +213,56 @@ func DenyPrivateIP() func(ip net.IP) error {
 	}
 }
 
-func validateIP(value string, restrictions ...func(ip net.IP) error) error {
-	ip := net.ParseIP(value)
-	if ip == nil {
-		return ErrInvalid
+// AllowOnlyPrefixes denies using of IPs that are not contained in any of the given prefixes.
+// The address is checked without the zone. IPv4-mapped IPv6 addresses and prefixes (e.g. "::ffff:10.0.0.0/104")
+// are checked as IPv4 ones, so "10.0.0.0/8" contains both "10.1.1.1" and "::ffff:10.1.1.1".
+func AllowOnlyPrefixes(prefixes ...netip.Prefix) func(ip netip.Addr) error {
+	prefixes = unmapPrefixes(prefixes)
+
+	return func(ip netip.Addr) error {
+		if !containsIP(prefixes, ip) {
+			return ErrProhibited
+		}
+
+		return nil
 	}
-	for _, check := range restrictions {
-		if err := check(ip); err != nil {
-			return err
+}
+
+func denyIP(isDenied func(ip netip.Addr) bool) func(ip netip.Addr) error {
+	return func(ip netip.Addr) error {
+		if isDenied(ip.WithZone("").Unmap()) {
+			return ErrProhibited
 		}
+
+		return nil
 	}
+}
 
-	return nil
+// containsIP checks the address without the zone. The prefixes must be unmapped by [unmapPrefixes].
+func containsIP(prefixes []netip.Prefix, ip netip.Addr) bool {
+	ip = ip.WithZone("")
+	unmapped := ip.Unmap()
+	for _, prefix := range prefixes {
+		if prefix.Contains(ip) || prefix.Contains(unmapped) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// unmapPrefixes converts IPv4-mapped IPv6 prefixes (e.g. "::ffff:10.0.0.0/104") into IPv4 prefixes
+// ("10.0.0.0/8"), so they contain both IPv4 and IPv4-mapped IPv6 addresses.
+func unmapPrefixes(prefixes []netip.Prefix) []netip.Prefix {
+	unmapped := make([]netip.Prefix, len(prefixes))
+	for i, prefix := range prefixes {
+		if prefix.Addr().Is4In6() && prefix.Bits() >= 96 {
+			prefix = netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()-96)
+		}
+		unmapped[i] = prefix
+	}
+
+	return unmapped
 }
 
 const (
@@ -1,6 +1,7 @@
 package validate_test
 
 import (
+	"net/netip"
 	"regexp"
 	"strings"
 	"testing"
@@ -340,6 +341,102 @@ func TestIPv6_WhenDenyPrivateAndInvalidIP_ExpectError(t *testing.T) {
 	}
 }
 
+func TestIP_WhenPresets_ExpectProhibited(t *testing.T) {
+	tests := []struct {
+		name        string
+		restriction func(ip netip.Addr) error
+		denied      []string
+		allowed     []string
+	}{
+		{
+			name:        "DenyLoopback",
+			restriction: validate.DenyLoopback(),
+			denied:      []string{"127.0.0.1", "127.255.0.1", "::1", "::ffff:127.0.0.1"},
+			allowed:     []string{"8.8.8.8", "10.0.0.1", "::2"},
+		},
+		{
+			name:        "DenyLinkLocal",
+			restriction: validate.DenyLinkLocal(),
+			denied:      []string{"169.254.169.254", "224.0.0.251", "fe80::1", "fe80::1%eth0", "ff02::1"},
+			allowed:     []string{"8.8.8.8", "224.1.1.1", "2001:4860::8888"},
+		},
+		{
+			name:        "DenyMulticast",
+			restriction: validate.DenyMulticast(),
+			denied:      []string{"224.0.0.1", "239.255.255.250", "ff02::1", "ff0e::1"},
+			allowed:     []string{"8.8.8.8", "fe80::1"},
+		},
+		{
+			name:        "DenyReserved",
+			restriction: validate.DenyReserved(),
+			denied: []string{
+				"0.0.0.0", "0.1.2.3", "100.64.0.1", "192.0.2.1", "198.18.0.1", "203.0.113.5",
+				"240.0.0.1", "255.255.255.255", "::", "2001:db8::1", "100::1", "::ffff:192.0.2.1",
+			},
+			allowed: []string{"8.8.8.8", "10.0.0.1", "2606:4700:4700::1111"},
+		},
+		{
+			name:        "DenyPrivateIP",
+			restriction: validate.DenyPrivateIP(),
+			denied:      []string{"10.0.0.1", "172.16.0.1", "192.168.1.1", "fd00::1", "::ffff:192.168.1.1"},
+			allowed:     []string{"8.8.8.8", "127.0.0.1"},
+		},
+		{
+			name:        "DenyPrefixes",
+			restriction: validate.DenyPrefixes(netip.MustParsePrefix("203.0.113.0/24"), netip.MustParsePrefix("2001:db8::/32")),
+			denied:      []string{"203.0.113.7", "::ffff:203.0.113.7", "2001:db8::1", "2001:db8::1%eth0"},
+			allowed:     []string{"203.0.114.1", "2001:db9::1"},
+		},
+		{
+			name:        "AllowOnlyPrefixes",
+			restriction: validate.AllowOnlyPrefixes(netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("::ffff:192.0.2.0/120")),
+			denied:      []string{"11.0.0.1", "::ffff:11.0.0.1", "2001:db8::1"},
+			allowed:     []string{"10.1.2.3", "::ffff:10.1.2.3", "192.0.2.5", "::ffff:192.0.2.5"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for _, ip := range test.denied {
+				assert.ErrorIs(t, validate.IPAddrWithZone(netip.MustParseAddr(ip), test.restriction), validate.ErrProhibited, ip)
+			}
+			for _, ip := range test.allowed {
+				assert.NoError(t, validate.IPAddrWithZone(netip.MustParseAddr(ip), test.restriction), ip)
+			}
+		})
+	}
+}
+
+func TestIP_WhenZone_ExpectZoneHandled(t *testing.T) {
+	zoned := netip.MustParseAddr("fe80::1%eth0")
+
+	assert.ErrorIs(t, validate.IP("fe80::1%eth0"), validate.ErrInvalid)
+	assert.ErrorIs(t, validate.IPv6("fe80::1%25"), validate.ErrInvalid)
+	assert.ErrorIs(t, validate.IPv4("127.0.0.1%eth0"), validate.ErrInvalid)
+	assert.ErrorIs(t, validate.IPAddr(zoned), validate.ErrInvalid)
+	assert.NoError(t, validate.IPAddrWithZone(zoned))
+	assert.ErrorIs(t, validate.IPAddrWithZone(zoned, validate.DenyLinkLocal()), validate.ErrProhibited)
+	assert.ErrorIs(t, validate.IPAddrWithZone(netip.Addr{}), validate.ErrInvalid)
+}
+
+func TestIP_WhenIPv4MappedPrefixes_ExpectCheckedAsIPv4(t *testing.T) {
+	mapped := validate.AllowOnlyPrefixes(netip.MustParsePrefix("::ffff:10.0.0.0/104"))
+	ipv4 := validate.AllowOnlyPrefixes(netip.MustParsePrefix("10.0.0.0/8"))
+
+	assert.NoError(t, validate.IP("10.1.1.1", mapped))
+	assert.NoError(t, validate.IP("::ffff:10.1.1.1", mapped))
+	assert.NoError(t, validate.IP("10.1.1.1", ipv4))
+	assert.NoError(t, validate.IP("::ffff:10.1.1.1", ipv4))
+	assert.ErrorIs(t, validate.IP("11.1.1.1", mapped), validate.ErrProhibited)
+	assert.ErrorIs(t, validate.IP("::ffff:11.1.1.1", ipv4), validate.ErrProhibited)
+	assert.ErrorIs(t, validate.IP("10.1.1.1", validate.DenyPrefixes(netip.MustParsePrefix("::ffff:10.0.0.0/104"))), validate.ErrProhibited)
+}
+
+func TestIPAddr(t *testing.T) {
+	assert.NoError(t, validate.IPAddr(netip.MustParseAddr("8.8.8.8"), validate.DenyPrivateIP()))
+	assert.ErrorIs(t, validate.IPAddr(netip.MustParseAddr("10.0.0.1"), validate.DenyPrivateIP()), validate.ErrProhibited)
+	assert.ErrorIs(t, validate.IPAddr(netip.Addr{}), validate.ErrInvalid)
+}
+
 var validIPsV4 = []string{
 	"0.0.0.0",
 	"10.0.0.0",
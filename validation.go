@@ -45,7 +45,8 @@ func (f ValidatableFunc) Validate(ctx context.Context, validator *Validator) err
 }
 
 // Filter is used for processing the list of errors to return a single [ViolationList].
-// If there is at least one non-violation error it will return it instead.
+// If there is at least one non-violation error it will return it instead. The result is nil
+// if there are no blocking violations (see [ViolationList.AsError]).
 func Filter(violations ...error) error {
 	list := &ViolationList{}
 
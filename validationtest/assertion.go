@@ -328,6 +328,26 @@ func (a *ViolationAssertion) WithMessage(message string) *ViolationAssertion {
 	return a
 }
 
+// WithSeverity checks that violation has expected level (see [validation.SeverityOf]).
+func (a *ViolationAssertion) WithSeverity(severity validation.Severity) *ViolationAssertion {
+	if a == nil {
+		return nil
+	}
+	a.t.Helper()
+
+	actual := validation.SeverityOf(a.violation)
+	if actual != severity {
+		assert.Fail(a.t, fmt.Sprintf(
+			`failed asserting that violation%s has severity "%s", actual is "%s"`,
+			a.atIndex(),
+			severity,
+			actual,
+		))
+	}
+
+	return a
+}
+
 // WithPropertyPath checks that the tested violation has an expected property path.
 func (a *ViolationAssertion) WithPropertyPath(path string) *ViolationAssertion {
 	if a == nil {
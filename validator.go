@@ -17,7 +17,14 @@ type Validator struct {
 	language         language.Tag
 	translator       Translator
 	violationFactory ViolationFactory
+	constraints      *ConstraintRegistry
+	schema           *jsonSchemaBuilder
 	groups           []string
+	maxViolations    int
+	memoize          bool
+	observer         Observer
+	isSensitive      bool
+	run              *validationRun
 }
 
 // Translator is used to translate violation messages. By default, validator uses an implementation from
@@ -32,6 +39,10 @@ type ValidatorOptions struct {
 	translatorOptions []translations.TranslatorOption
 	translator        Translator
 	violationFactory  ViolationFactory
+	constraints       *ConstraintRegistry
+	maxViolations     int
+	memoize           bool
+	observer          Observer
 }
 
 func newValidatorOptions() *ValidatorOptions {
@@ -66,10 +77,17 @@ func NewValidator(options ...ValidatorOption) (*Validator, error) {
 	if opts.violationFactory == nil {
 		opts.violationFactory = NewViolationFactory(opts.translator)
 	}
+	if opts.constraints == nil {
+		opts.constraints = DefaultConstraintRegistry()
+	}
 
 	validator := &Validator{
 		translator:       opts.translator,
 		violationFactory: opts.violationFactory,
+		constraints:      opts.constraints,
+		maxViolations:    opts.maxViolations,
+		memoize:          opts.memoize,
+		observer:         opts.observer,
 	}
 
 	return validator, nil
@@ -116,24 +134,109 @@ func SetViolationFactory(factory ViolationFactory) ValidatorOption {
 	}
 }
 
+// SetConstraintRegistry option is used to set up the registry of constraints that are resolved by name,
+// for example, from the struct tags. By default, the validator uses [DefaultConstraintRegistry].
+func SetConstraintRegistry(registry *ConstraintRegistry) ValidatorOption {
+	return func(options *ValidatorOptions) error {
+		options.constraints = registry
+
+		return nil
+	}
+}
+
+// MaxViolations option is used to limit the number of violations collected by the single call
+// of the [Validator.Validate] method. See [Validator.WithMaxViolations] for details.
+func MaxViolations(n int) ValidatorOption {
+	return func(options *ValidatorOptions) error {
+		if n < 0 {
+			return errNegativeMaxViolations
+		}
+		options.maxViolations = n
+
+		return nil
+	}
+}
+
+// MemoizeValidatables option enables memoization of the validation results of [Validatable] values.
+// See [Validator.WithMemoization] for details.
+func MemoizeValidatables() ValidatorOption {
+	return func(options *ValidatorOptions) error {
+		options.memoize = true
+
+		return nil
+	}
+}
+
+// SetObserver option is used to set up the [Observer] receiving the events of the validation process,
+// for example, to collect metrics or to trace the validation.
+func SetObserver(observer Observer) ValidatorOption {
+	return func(options *ValidatorOptions) error {
+		options.observer = observer
+
+		return nil
+	}
+}
+
 // Validate is the main validation method. It accepts validation arguments that can be
 // used to tune up the validation process or to pass values of a specific type.
+//
+// The result is nil if there are no blocking violations (see [Severity]). The non-blocking violations
+// are returned alongside the blocking ones. Use [Validator.ValidateWithWarnings] to get the non-blocking
+// violations when the validation is successful. In the nested validation (for example, inside
+// the [Validatable.Validate] method), the non-blocking violations are returned as is,
+// so they are passed to the caller.
 func (validator *Validator) Validate(ctx context.Context, arguments ...Argument) error {
+	violations, isRoot, err := validator.validate(ctx, arguments)
+	if err != nil {
+		return err
+	}
+	if isRoot {
+		return violations.AsError()
+	}
+
+	return violations.asError()
+}
+
+// ValidateWithWarnings works exactly as [Validator.Validate] method, but it also returns the list
+// of non-blocking violations (warnings and notices, see [Severity]), even if the validation is successful.
+// The error contains all violations, if there are blocking ones.
+func (validator *Validator) ValidateWithWarnings(ctx context.Context, arguments ...Argument) (*ViolationList, error) {
+	violations, _, err := validator.validate(ctx, arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	return violations.Warnings(), violations.AsError()
+}
+
+// validate runs the validation process and returns the violations and the flag
+// that the validation is not nested.
+func (validator *Validator) validate(ctx context.Context, arguments []Argument) (*ViolationList, bool, error) {
 	execContext := &executionContext{}
 	for _, argument := range arguments {
 		argument.setUp(execContext)
 	}
 
+	validator, ctx, isRunStarted := validator.startRun(ctx)
 	violations := &ViolationList{}
 	for _, validate := range execContext.validations {
+		if validator.isLimitReached() {
+			break
+		}
 		vs, err := validate(ctx, validator)
 		if err != nil {
-			return err
+			if isRunStarted && validator.observer != nil {
+				validator.observer.ErrorReturned(ctx, newErrorEvent(validator, err))
+			}
+			return nil, false, err
 		}
 		violations.Join(vs)
 	}
+	if isRunStarted {
+		validator.finishRun(violations)
+	}
 
-	return violations.AsError()
+	return violations, isRunStarted && validator.run.parent == nil, nil
 }
 
 // ValidateBool is an alias for validating a single boolean value.
@@ -181,6 +284,12 @@ func (validator *Validator) ValidateIt(ctx context.Context, validatable Validata
 	return validator.Validate(ctx, Valid(validatable))
 }
 
+// ValidateStruct is an alias for validating a struct by rules defined in the struct tags.
+// See [Struct] argument for details.
+func (validator *Validator) ValidateStruct(ctx context.Context, value any) error {
+	return validator.Validate(ctx, Struct(value))
+}
+
 // WithGroups is used to execute conditional validation based on validation groups. It creates
 // a new context validator with a given set of groups.
 //
@@ -241,6 +350,12 @@ func (validator *Validator) IsIgnoredForGroups(groups ...string) bool {
 	return !validator.IsAppliedForGroups(groups...)
 }
 
+// Constraint resolves the constraint registered under the given name in the [ConstraintRegistry]
+// of the validator. If the name is not registered, then [ConstraintNotFoundError] is returned.
+func (validator *Validator) Constraint(name string, valueType ValueType, options ConstraintOptions) (any, error) {
+	return validator.constraints.Constraint(name, valueType, options)
+}
+
 // CreateConstraintError creates a new [ConstraintError], which can be used to stop validation process
 // if constraint is not properly configured.
 func (validator *Validator) CreateConstraintError(constraintName, description string) *ConstraintError {
@@ -251,6 +366,43 @@ func (validator *Validator) CreateConstraintError(constraintName, description st
 	}
 }
 
+// WithMaxViolations method creates a new context validator that stops the validation process
+// once n violations are collected. The limit is applied to the whole run of the [Validator.Validate]
+// method, including nested validation of [Validatable] values, iterations over slices and maps,
+// and asynchronous validation. The returned [ViolationList] contains no more than n violations,
+// and it is marked as truncated (see [ViolationList.IsTruncated]) if some violations were discarded
+// or some values were not validated. Zero value means no limit.
+//
+// Violations are counted when they are created by the violation factory of the validator,
+// so the violations discarded by custom constraints are counted too (violations checked
+// by [AtLeastOneOf] are counted only if none of its arguments is satisfied). Asynchronous validation may collect
+// slightly more violations, the excess is cut off in the result.
+func (validator *Validator) WithMaxViolations(n int) *Validator {
+	v := validator.copy()
+	v.maxViolations = max(n, 0)
+
+	return v
+}
+
+// WithMemoization method creates a new context validator that validates each [Validatable] pointer
+// only once within the single call of the [Validator.Validate] method. It is useful for graphs of objects
+// referencing shared children (for example, the same author of many books). The memoized violations are
+// created again at every property path the value is reachable from. Values are identified by the pointer,
+// non-pointer values are always validated.
+//
+// Be careful, the memoized result does not depend on the validation groups and the language of the nested
+// validators, so the value is expected to be validated in the same way in all places.
+//
+// Regardless of memoization, pointers to [Validatable] values are checked for cyclic references:
+// if the value is reachable from itself, then the validation is stopped with the [ConstraintError]
+// instead of infinite recursion.
+func (validator *Validator) WithMemoization() *Validator {
+	v := validator.copy()
+	v.memoize = true
+
+	return v
+}
+
 // WithLanguage method creates a new context validator with a given language tag. All created violations
 // will be translated into this language.
 //
@@ -299,6 +451,9 @@ func (validator *Validator) CreateViolation(ctx context.Context, err error, mess
 func (validator *Validator) BuildViolation(ctx context.Context, err error, message string) *ViolationBuilder {
 	b := NewViolationBuilder(validator.violationFactory).BuildViolation(err, message)
 	b = b.SetPropertyPath(validator.propertyPath)
+	if validator.isSensitive {
+		b = b.Sensitive()
+	}
 
 	if validator.language != language.Und {
 		b = b.WithLanguage(validator.language)
@@ -313,6 +468,9 @@ func (validator *Validator) BuildViolation(ctx context.Context, err error, messa
 func (validator *Validator) BuildViolationList(ctx context.Context) *ViolationListBuilder {
 	b := NewViolationListBuilder(validator.violationFactory)
 	b = b.SetPropertyPath(validator.propertyPath)
+	if validator.isSensitive {
+		b = b.Sensitive()
+	}
 
 	if validator.language != language.Und {
 		b = b.WithLanguage(validator.language)
@@ -329,6 +487,13 @@ func (validator *Validator) copy() *Validator {
 		language:         validator.language,
 		translator:       validator.translator,
 		violationFactory: validator.violationFactory,
+		constraints:      validator.constraints,
+		schema:           validator.schema,
 		groups:           validator.groups,
+		maxViolations:    validator.maxViolations,
+		memoize:          validator.memoize,
+		observer:         validator.observer,
+		isSensitive:      validator.isSensitive,
+		run:              validator.run,
 	}
 }
@@ -61,6 +61,12 @@ func Validate(ctx context.Context, arguments ...validation.Argument) error {
 	return Default().Validate(ctx, arguments...)
 }
 
+// ValidateWithWarnings works exactly as [Validate] function, but it also returns the list
+// of non-blocking violations (see [validation.Validator.ValidateWithWarnings]).
+func ValidateWithWarnings(ctx context.Context, arguments ...validation.Argument) (*validation.ViolationList, error) {
+	return Default().ValidateWithWarnings(ctx, arguments...)
+}
+
 // ValidateBool is an alias for validating a single boolean value.
 func ValidateBool(ctx context.Context, value bool, constraints ...validation.BoolConstraint) error {
 	return Default().ValidateBool(ctx, value, constraints...)
@@ -106,6 +112,17 @@ func ValidateIt(ctx context.Context, validatable validation.Validatable) error {
 	return Default().ValidateIt(ctx, validatable)
 }
 
+// ValidateStruct is an alias for validating a struct by rules defined in the struct tags.
+func ValidateStruct(ctx context.Context, value any) error {
+	return Default().ValidateStruct(ctx, value)
+}
+
+// DescribeJSONSchema describes the constraints of the arguments as a JSON Schema document
+// instead of validating the values.
+func DescribeJSONSchema(ctx context.Context, arguments ...validation.Argument) (*validation.JSONSchema, error) {
+	return Default().DescribeJSONSchema(ctx, arguments...)
+}
+
 // WithGroups is used to execute conditional validation based on validation groups. It creates
 // a new context validator with a given set of groups.
 //
@@ -125,6 +142,20 @@ func WithGroups(groups ...string) *validation.Validator {
 	return Default().WithGroups(groups...)
 }
 
+// WithMaxViolations method creates a new context validator that stops the validation process
+// once n violations are collected. The resulting list of violations is marked as truncated
+// if some violations were discarded or some values were not validated.
+func WithMaxViolations(n int) *validation.Validator {
+	return Default().WithMaxViolations(n)
+}
+
+// WithMemoization method creates a new context validator that validates each [validation.Validatable]
+// pointer only once within the single validation run and creates its violations at every path
+// the value is reachable from.
+func WithMemoization() *validation.Validator {
+	return Default().WithMemoization()
+}
+
 // WithLanguage method creates a new context validator with a given language tag. All created violations
 // will be translated into this language.
 func WithLanguage(tag language.Tag) *validation.Validator {
@@ -0,0 +1,179 @@
+package validation
+
+import (
+	"fmt"
+)
+
+// ViolationData is a lossless serializable form of the [Violation]. It can be used to pass violations
+// across process boundaries: it can be encoded into JSON by the [encoding/json] package, and it is
+// compatible with the protobuf message defined as:
+//
+//	message Violation {
+//	  string error = 1;
+//	  string message = 2;
+//	  string message_template = 3;
+//	  repeated TemplateParameter parameters = 4;
+//	  int32 plural_count = 5;
+//	  string property_path = 6;
+//	  string severity = 7;
+//	}
+//
+//	message TemplateParameter {
+//	  string key = 1;
+//	  string value = 2;
+//	  bool needs_translation = 3;
+//	  bool sensitive = 4;
+//	}
+//
+// JSON field names are a superset of the fields produced by [ViolationList.MarshalJSON].
+// Use [ViolationData.Violation] to decode the data back into the violation.
+type ViolationData struct {
+	// Error is the code of the underlying static error (see [Error]).
+	Error string `json:"error,omitempty" protobuf:"bytes,1,opt,name=error,proto3"`
+	// Message is the translated message of the violation.
+	Message string `json:"message" protobuf:"bytes,2,opt,name=message,proto3"`
+	// MessageTemplate is the template for rendering the message.
+	MessageTemplate string `json:"messageTemplate,omitempty" protobuf:"bytes,3,opt,name=message_template,json=messageTemplate,proto3"`
+	// Parameters are the template parameters of the message.
+	Parameters []*TemplateParameterData `json:"parameters,omitempty" protobuf:"bytes,4,rep,name=parameters,proto3"`
+	// PluralCount is the count used to choose the plural form of the message.
+	PluralCount int32 `json:"pluralCount,omitempty" protobuf:"varint,5,opt,name=plural_count,json=pluralCount,proto3"`
+	// PropertyPath is the string representation of the [PropertyPath].
+	PropertyPath string `json:"propertyPath,omitempty" protobuf:"bytes,6,opt,name=property_path,json=propertyPath,proto3"`
+	// Severity is the name of the non-blocking level of the violation (see [Severity]), empty for errors.
+	Severity string `json:"severity,omitempty" protobuf:"bytes,7,opt,name=severity,proto3"`
+	// Payload is the data attached to the violation by the constraint (see [PayloadOf]).
+	// It is encoded only into JSON, and it is decoded as a generic JSON value (for example, map[string]any).
+	Payload any `json:"payload,omitempty" protobuf:"-"`
+}
+
+// TemplateParameterData is a serializable form of the [TemplateParameter].
+type TemplateParameterData struct {
+	Key              string `json:"key" protobuf:"bytes,1,opt,name=key,proto3"`
+	Value            string `json:"value" protobuf:"bytes,2,opt,name=value,proto3"`
+	NeedsTranslation bool   `json:"needsTranslation,omitempty" protobuf:"varint,3,opt,name=needs_translation,json=needsTranslation,proto3"`
+	Sensitive        bool   `json:"sensitive,omitempty" protobuf:"varint,4,opt,name=sensitive,proto3"`
+}
+
+// ViolationListData is a lossless serializable form of the [ViolationList]. It is compatible
+// with the protobuf message defined as:
+//
+//	message ViolationList {
+//	  repeated Violation violations = 1;
+//	  bool truncated = 2;
+//	}
+type ViolationListData struct {
+	Violations []*ViolationData `json:"violations" protobuf:"bytes,1,rep,name=violations,proto3"`
+	// Truncated is set if the list does not contain all violations (see [ViolationList.IsTruncated]).
+	Truncated bool `json:"truncated,omitempty" protobuf:"varint,2,opt,name=truncated,proto3"`
+}
+
+// NewViolationData converts the violation into its serializable form. The plural count is taken
+// from the violation if it implements the PluralCount() int method (violations created by
+// the [BuiltinViolationFactory] implement it).
+func NewViolationData(violation Violation) *ViolationData {
+	data := &ViolationData{
+		Message:         violation.Message(),
+		MessageTemplate: violation.MessageTemplate(),
+	}
+	if err := violation.Unwrap(); err != nil {
+		data.Error = err.Error()
+	}
+	if severity := SeverityOf(violation); !severity.IsBlocking() {
+		data.Severity = severity.String()
+	}
+	data.Payload = PayloadOf(violation)
+	if v, ok := violation.(interface{ PluralCount() int }); ok {
+		data.PluralCount = int32(v.PluralCount()) //nolint:gosec // plural count is a small number
+	}
+	if path := violation.PropertyPath(); path != nil {
+		data.PropertyPath = path.String()
+	}
+	for _, parameter := range violation.Parameters() {
+		data.Parameters = append(data.Parameters, &TemplateParameterData{
+			Key:              parameter.Key,
+			Value:            parameter.Value,
+			NeedsTranslation: parameter.NeedsTranslation,
+			Sensitive:        parameter.Sensitive,
+		})
+	}
+
+	return data
+}
+
+// Violation decodes the data into the violation. The underlying error of the violation is resolved
+// by the [ErrorByCode] function, so the decoded violation can be tested by [errors.Is] with
+// the static errors (for example, [ErrIsBlank]). If the error code is not registered, then
+// a new [Error] with the code and the message template is used.
+//
+// The message is not translated again. To translate the message into another language, use
+// the [ViolationBuilder] with the message template, parameters, and plural count of the data.
+func (data *ViolationData) Violation() (Violation, error) {
+	violation := &internalViolation{
+		message:         data.Message,
+		messageTemplate: data.MessageTemplate,
+		pluralCount:     int(data.PluralCount),
+	}
+	if data.Error != "" {
+		violation.err = errorByCodeOrNew(data.Error, data.MessageTemplate)
+	}
+	if err := violation.severity.UnmarshalText([]byte(data.Severity)); err != nil {
+		return nil, fmt.Errorf("decode severity: %w", err)
+	}
+	violation.payload = data.Payload
+	if data.PropertyPath != "" {
+		violation.propertyPath = &PropertyPath{}
+		if err := violation.propertyPath.UnmarshalText([]byte(data.PropertyPath)); err != nil {
+			return nil, fmt.Errorf(`decode property path "%s": %w`, data.PropertyPath, err)
+		}
+	}
+	if len(data.Parameters) > 0 {
+		violation.parameters = make([]TemplateParameter, len(data.Parameters))
+		for i, parameter := range data.Parameters {
+			violation.parameters[i] = TemplateParameter{
+				Key:              parameter.Key,
+				Value:            parameter.Value,
+				NeedsTranslation: parameter.NeedsTranslation,
+				Sensitive:        parameter.Sensitive,
+			}
+		}
+	}
+
+	return violation, nil
+}
+
+// NewViolationListData converts the list of violations into its serializable form.
+func NewViolationListData(violations *ViolationList) *ViolationListData {
+	data := &ViolationListData{
+		Violations: make([]*ViolationData, 0, violations.Len()),
+		Truncated:  violations.IsTruncated(),
+	}
+	for _, violation := range violations.All() {
+		data.Violations = append(data.Violations, NewViolationData(violation))
+	}
+
+	return data
+}
+
+// ViolationList decodes the data into the list of violations (see [ViolationData.Violation]).
+func (data *ViolationListData) ViolationList() (*ViolationList, error) {
+	violations := NewViolationList()
+	for i, v := range data.Violations {
+		violation, err := v.Violation()
+		if err != nil {
+			return nil, fmt.Errorf("decode violation #%d: %w", i, err)
+		}
+		violations.Append(violation)
+	}
+	violations.isTruncated = data.Truncated
+
+	return violations, nil
+}
+
+func errorByCodeOrNew(code, message string) *Error {
+	if err, ok := ErrorByCode(code); ok {
+		return err
+	}
+
+	return &Error{code: code, message: message}
+}
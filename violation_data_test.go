@@ -0,0 +1,169 @@
+package validation_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var ErrCustom = validation.NewError("custom error", "Custom message {{ key }}.")
+
+func TestViolationData_WhenRoundTrip_ExpectEqualViolation(t *testing.T) {
+	violation := newValidator(t).BuildViolation(context.Background(), validation.ErrTooLong, validation.ErrTooLong.Message()).
+		WithPluralCount(5).
+		WithParameters(
+			validation.TemplateParameter{Key: "{{ limit }}", Value: "5"},
+			validation.TemplateParameter{Key: "{{ value }}", Value: "abcdef", NeedsTranslation: true},
+		).
+		At(validation.PropertyName("tags"), validation.ArrayIndex(1)).
+		Create()
+
+	data, err := json.Marshal(validation.NewViolationData(violation))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"error": "is too long",
+		"message": "This value is too long. It should have 5 characters or less.",
+		"messageTemplate": "This value is too long. It should have {{ limit }} character(s) or less.",
+		"parameters": [
+			{"key": "{{ limit }}", "value": "5"},
+			{"key": "{{ value }}", "value": "abcdef", "needsTranslation": true}
+		],
+		"pluralCount": 5,
+		"propertyPath": "tags[1]"
+	}`, string(data))
+
+	var decoded validation.ViolationData
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	restored, err := decoded.Violation()
+
+	require.NoError(t, err)
+	assert.Same(t, validation.ErrTooLong, restored.Unwrap())
+	assert.True(t, errors.Is(restored, validation.ErrTooLong))
+	assert.Equal(t, violation.Message(), restored.Message())
+	assert.Equal(t, violation.MessageTemplate(), restored.MessageTemplate())
+	assert.Equal(t, violation.Parameters(), restored.Parameters())
+	assert.Equal(t, violation.PropertyPath().String(), restored.PropertyPath().String())
+	assert.Equal(t, violation.Error(), restored.Error())
+	assert.Equal(t, validation.NewViolationData(violation), validation.NewViolationData(restored))
+}
+
+func TestViolationData_Violation_WhenCustomRegisteredError_ExpectStaticError(t *testing.T) {
+	data := &validation.ViolationData{Error: "custom error", Message: "Custom message."}
+
+	violation, err := data.Violation()
+
+	require.NoError(t, err)
+	assert.Same(t, ErrCustom, violation.Unwrap())
+	assert.Nil(t, violation.PropertyPath())
+}
+
+func TestViolationData_Violation_WhenUnknownErrorCode_ExpectNewError(t *testing.T) {
+	data := &validation.ViolationData{Error: "unknown code", MessageTemplate: "Unknown."}
+
+	violation, err := data.Violation()
+
+	require.NoError(t, err)
+	var staticErr *validation.Error
+	if assert.True(t, errors.As(violation.Unwrap(), &staticErr)) {
+		assert.Equal(t, "unknown code", staticErr.Error())
+		assert.Equal(t, "Unknown.", staticErr.Message())
+	}
+	_, registered := validation.ErrorByCode("unknown code")
+	assert.False(t, registered)
+}
+
+func TestViolationData_Violation_WhenNoErrorCode_ExpectNilError(t *testing.T) {
+	violation, err := (&validation.ViolationData{Message: "message"}).Violation()
+
+	require.NoError(t, err)
+	assert.NoError(t, violation.Unwrap())
+}
+
+func TestViolationData_Violation_WhenInvalidPropertyPath_ExpectError(t *testing.T) {
+	_, err := (&validation.ViolationData{PropertyPath: "tags["}).Violation()
+
+	assert.ErrorContains(t, err, `decode property path "tags["`)
+}
+
+func TestViolationListData_WhenRoundTrip_ExpectEqualList(t *testing.T) {
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.StringProperty("title", "", it.IsNotBlank()),
+		validation.CountableProperty("tags", 0, it.HasMinCount(2)),
+	)
+	violations, ok := validation.UnwrapViolations(err)
+	require.True(t, ok)
+
+	data := validation.NewViolationListData(violations)
+	restored, err := data.ViolationList()
+
+	require.NoError(t, err)
+	assert.Equal(t, data, validation.NewViolationListData(restored))
+	assert.True(t, errors.Is(restored, validation.ErrIsBlank))
+	assert.True(t, errors.Is(restored, validation.ErrTooFewElements))
+}
+
+func TestViolationListData_WhenTruncatedList_ExpectTruncatedFlagRestored(t *testing.T) {
+	err := newValidator(t).WithMaxViolations(1).Validate(
+		context.Background(),
+		validation.EachString([]string{"", ""}, it.IsNotBlank()),
+	)
+	violations, ok := validation.UnwrapViolations(err)
+	require.True(t, ok)
+
+	data, err := json.Marshal(validation.NewViolationListData(violations))
+	require.NoError(t, err)
+	var decoded validation.ViolationListData
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	restored, err := decoded.ViolationList()
+
+	require.NoError(t, err)
+	assert.True(t, decoded.Truncated)
+	assert.True(t, restored.IsTruncated())
+	assert.Equal(t, 1, restored.Len())
+}
+
+func TestViolationListData_ViolationList_WhenInvalidViolation_ExpectError(t *testing.T) {
+	data := &validation.ViolationListData{Violations: []*validation.ViolationData{
+		{Message: "valid"},
+		{PropertyPath: "["},
+	}}
+
+	_, err := data.ViolationList()
+
+	assert.ErrorContains(t, err, "decode violation #1")
+}
+
+func TestViolationList_UnmarshalJSON_WhenMarshaledList_ExpectRestoredList(t *testing.T) {
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.StringProperty("title", "", it.IsNotBlank()),
+		validation.AtProperty("tags", validation.EachString([]string{""}, it.IsNotBlank())),
+	)
+	data, marshalErr := json.Marshal(err)
+	require.NoError(t, marshalErr)
+
+	var violations validation.ViolationList
+	require.NoError(t, json.Unmarshal(data, &violations))
+
+	assert.Equal(t, 2, violations.Len())
+	assert.Same(t, validation.ErrIsBlank, violations.First().Unwrap())
+	assert.Equal(t, "This value should not be blank.", violations.First().Message())
+	assert.Equal(t, "tags[0]", violations.Last().PropertyPath().String())
+	restored, marshalErr := json.Marshal(&violations)
+	require.NoError(t, marshalErr)
+	assert.JSONEq(t, string(data), string(restored))
+}
+
+func TestErrorByCode_WhenBuiltinError_ExpectStaticError(t *testing.T) {
+	err, ok := validation.ErrorByCode("is blank")
+
+	assert.True(t, ok)
+	assert.Same(t, validation.ErrIsBlank, err)
+}
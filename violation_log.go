@@ -0,0 +1,126 @@
+package validation
+
+import (
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// RedactedValue replaces the values of the template parameters of sensitive properties
+// in the structured logs (see [ViolationList.WithSensitiveProperties]).
+const RedactedValue = "[REDACTED]"
+
+// LogValue implements the [slog.LogValuer] interface. The list is logged as a group
+// with the number of violations, the truncation flag (if the list is truncated),
+// and a group for each violation keyed by its index. See [ViolationList.WithSensitiveProperties]
+// to redact the values of the template parameters for sensitive properties.
+func (list *ViolationList) LogValue() slog.Value {
+	return list.logValue(nil)
+}
+
+// WithSensitiveProperties returns the [slog.LogValuer] logging the list the same way as
+// [ViolationList.LogValue], but the values of the template parameters of the violations are replaced
+// by [RedactedValue] if the property path of the violation contains any of the given property names.
+// It can be used to prevent leaking of passwords, card numbers, etc. into logs.
+//
+//	logger.Error("invalid request", slog.Any("violations", violations.WithSensitiveProperties("password", "cardNumber")))
+func (list *ViolationList) WithSensitiveProperties(properties ...string) slog.LogValuer {
+	return redactedViolationList{list: list, properties: properties}
+}
+
+type redactedViolationList struct {
+	list       *ViolationList
+	properties []string
+}
+
+func (r redactedViolationList) LogValue() slog.Value {
+	return r.list.logValue(r.properties)
+}
+
+func (list *ViolationList) logValue(sensitiveProperties []string) slog.Value {
+	attrs := make([]slog.Attr, 0, list.Len()+2)
+	attrs = append(attrs, slog.Int("count", list.Len()))
+	if list.IsTruncated() {
+		attrs = append(attrs, slog.Bool("truncated", true))
+	}
+	for i, violation := range list.All() {
+		value := slog.AnyValue(violation)
+		if isSensitivePath(violation.PropertyPath(), sensitiveProperties) {
+			value = violationLogValue(violation, true)
+		} else if _, ok := violation.(slog.LogValuer); !ok {
+			value = violationLogValue(violation, false)
+		}
+		attrs = append(attrs, slog.Attr{Key: strconv.Itoa(i), Value: value})
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// LogValue implements the [slog.LogValuer] interface. The violation is logged as a group
+// with the property path, error code, severity (for non-blocking violations), message, message template,
+// and template parameters.
+func (v *internalViolation) LogValue() slog.Value {
+	return violationLogValue(v, false)
+}
+
+// LogValue implements the [slog.LogValuer] interface by the underlying violation. If the violation
+// does not implement it, then it is logged the same way as built-in violations.
+func (element *ViolationListElement) LogValue() slog.Value {
+	if v, ok := element.violation.(slog.LogValuer); ok {
+		return v.LogValue()
+	}
+
+	return violationLogValue(element.violation, false)
+}
+
+func violationLogValue(violation Violation, redact bool) slog.Value {
+	attrs := make([]slog.Attr, 0, 6)
+	if path := violation.PropertyPath(); path != nil {
+		attrs = append(attrs, slog.String("path", path.String()))
+	}
+	if err := violation.Unwrap(); err != nil {
+		attrs = append(attrs, slog.String("code", err.Error()))
+	}
+	if severity := SeverityOf(violation); !severity.IsBlocking() {
+		attrs = append(attrs, slog.String("severity", severity.String()))
+	}
+	if !redact {
+		attrs = append(attrs, slog.String("message", violation.Message()))
+	}
+	attrs = append(attrs, slog.String("template", violation.MessageTemplate()))
+
+	parameters := violation.Parameters()
+	if len(parameters) > 0 {
+		values := make([]slog.Attr, 0, len(parameters))
+		for _, parameter := range parameters {
+			value := parameter.Value
+			if redact {
+				value = RedactedValue
+			}
+			values = append(values, slog.String(parameterLogKey(parameter.Key), value))
+		}
+		attrs = append(attrs, slog.Attr{Key: "parameters", Value: slog.GroupValue(values...)})
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// parameterLogKey returns the key of the template parameter without curly braces,
+// for example, "limit" for "{{ limit }}".
+func parameterLogKey(key string) string {
+	return strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(key, "{{"), "}}"))
+}
+
+func isSensitivePath(path *PropertyPath, sensitiveProperties []string) bool {
+	if len(sensitiveProperties) == 0 {
+		return false
+	}
+	for _, element := range path.All() {
+		if name, ok := element.(PropertyName); ok && slices.Contains(sensitiveProperties, string(name)) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,97 @@
+package validation_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func logJSON(t *testing.T, value any) string {
+	t.Helper()
+	var buffer bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buffer, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey || a.Key == slog.MessageKey) {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Info("", slog.Any("violations", value))
+	return buffer.String()
+}
+
+func TestViolationList_LogValue_ExpectGroupsWithPathCodeTemplateAndParameters(t *testing.T) {
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.StringProperty("name", "", it.IsNotBlank()),
+		validation.StringProperty("password", "abc", it.HasMinLength(5)),
+	)
+	violations, ok := validation.UnwrapViolations(err)
+	require.True(t, ok)
+
+	assert.JSONEq(t, `{"violations": {
+		"count": 2,
+		"0": {
+			"path": "name",
+			"code": "is blank",
+			"message": "This value should not be blank.",
+			"template": "This value should not be blank."
+		},
+		"1": {
+			"path": "password",
+			"code": "is too short",
+			"message": "This value is too short. It should have 5 characters or more.",
+			"template": "This value is too short. It should have {{ limit }} character(s) or more.",
+			"parameters": {"length": "3", "limit": "5", "value": "\"abc\""}
+		}
+	}}`, logJSON(t, violations))
+}
+
+func TestViolationList_WithSensitiveProperties_ExpectRedactedParameters(t *testing.T) {
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.StringProperty("name", "ab", it.HasMinLength(3)),
+		validation.StringProperty("password", "abc", it.HasMinLength(5)),
+	)
+	violations, ok := validation.UnwrapViolations(err)
+	require.True(t, ok)
+
+	output := logJSON(t, violations.WithSensitiveProperties("password"))
+
+	assert.Contains(t, output, `"parameters":{"value":"\"ab\"","length":"2","limit":"3"}`)
+	assert.Contains(t, output, `"parameters":{"value":"[REDACTED]","length":"[REDACTED]","limit":"[REDACTED]"}`)
+	assert.NotContains(t, output, "abc")
+	assert.NotContains(t, output, "It should have 5 characters")
+}
+
+func TestViolationList_LogValue_WhenTruncated_ExpectTruncatedFlag(t *testing.T) {
+	err := newValidator(t).WithMaxViolations(1).Validate(
+		context.Background(),
+		validation.Each([]string{"", ""}, it.IsNotBlank()),
+	)
+	violations, ok := validation.UnwrapViolations(err)
+	require.True(t, ok)
+
+	output := logJSON(t, violations)
+
+	assert.Contains(t, output, `"count":1,"truncated":true`)
+}
+
+func TestViolation_LogValue_ExpectGroup(t *testing.T) {
+	violation := newValidator(t).BuildViolation(context.Background(), validation.ErrTooLong, validation.ErrTooLong.Message()).
+		WithParameter("{{ limit }}", "5").
+		At(validation.PropertyName("tags"), validation.ArrayIndex(1)).
+		Create()
+
+	output := logJSON(t, violation)
+
+	assert.Contains(t, output, `"path":"tags[1]","code":"is too long"`)
+	assert.Contains(t, output, `"parameters":{"limit":"5"}`)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"iter"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -58,6 +59,50 @@ type ViolationFactory interface {
 	) Violation
 }
 
+// ViolationDetails holds the optional attributes of the violation passed to the [DetailedViolationFactory].
+type ViolationDetails struct {
+	// Severity is the level of the violation, [SeverityError] by default.
+	Severity Severity
+	// Payload is the arbitrary data attached to the violation by the constraint (see [PayloadOf]).
+	Payload any
+}
+
+// DetailedViolationFactory is the optional extension of the [ViolationFactory] that receives
+// the optional attributes of the violation. If the factory of the validator does not implement
+// this interface, then the attributes are ignored, and the violations are considered as blocking.
+// The [BuiltinViolationFactory] implements this interface.
+type DetailedViolationFactory interface {
+	ViolationFactory
+	// CreateDetailedViolation creates a new instance of [Violation] with the optional attributes.
+	CreateDetailedViolation(
+		err error,
+		messageTemplate string,
+		pluralCount int,
+		parameters []TemplateParameter,
+		propertyPath *PropertyPath,
+		lang language.Tag,
+		details ViolationDetails,
+	) Violation
+}
+
+// createViolation creates the violation by the factory passing the details if the factory supports them.
+func createViolation(
+	factory ViolationFactory,
+	err error,
+	messageTemplate string,
+	pluralCount int,
+	parameters []TemplateParameter,
+	propertyPath *PropertyPath,
+	lang language.Tag,
+	details ViolationDetails,
+) Violation {
+	if f, ok := factory.(DetailedViolationFactory); ok {
+		return f.CreateDetailedViolation(err, messageTemplate, pluralCount, parameters, propertyPath, lang, details)
+	}
+
+	return factory.CreateViolation(err, messageTemplate, pluralCount, parameters, propertyPath, lang)
+}
+
 // NewViolationFunc is an adapter that allows you to use ordinary functions as a [ViolationFactory].
 type NewViolationFunc func(
 	err error,
@@ -82,9 +127,10 @@ func (f NewViolationFunc) CreateViolation(
 
 // ViolationList is a linked list of violations. It is the usual type of error that is returned from a validator.
 type ViolationList struct {
-	len   int
-	first *ViolationListElement
-	last  *ViolationListElement
+	len         int
+	first       *ViolationListElement
+	last        *ViolationListElement
+	isTruncated bool
 }
 
 // ViolationListElement points to violation build by validator. It also implements
@@ -112,6 +158,17 @@ func (list *ViolationList) Len() int {
 	return list.len
 }
 
+// IsTruncated returns true if the list does not contain all violations, because the validation
+// process was stopped by the limit of violations (see [Validator.WithMaxViolations]).
+// It can be used to notify the end-user that there are more violations than presented.
+func (list *ViolationList) IsTruncated() bool {
+	if list == nil {
+		return false
+	}
+
+	return list.isTruncated
+}
+
 // ForEach can be used to iterate over [ViolationList] by a callback function. If callback returns
 // any error, then it will be returned as a result of ForEach function.
 func (list *ViolationList) ForEach(f func(i int, violation Violation) error) error {
@@ -175,8 +232,13 @@ func (list *ViolationList) Append(violations ...Violation) {
 }
 
 // Join is used to append the given violation list to the end of the current list.
+// If the given list is truncated, then the current list is marked as truncated too.
 func (list *ViolationList) Join(violations *ViolationList) {
-	if violations == nil || violations.len == 0 {
+	if violations == nil {
+		return
+	}
+	list.isTruncated = list.isTruncated || violations.isTruncated
+	if violations.len == 0 {
 		return
 	}
 
@@ -191,6 +253,28 @@ func (list *ViolationList) Join(violations *ViolationList) {
 	list.len += violations.len
 }
 
+// truncate cuts the list to the first n violations and marks it as truncated.
+func (list *ViolationList) truncate(n int) {
+	if n >= list.len {
+		return
+	}
+	list.isTruncated = true
+	if n == 0 {
+		list.first = nil
+		list.last = nil
+		list.len = 0
+		return
+	}
+
+	e := list.first
+	for i := 1; i < n; i++ {
+		e = e.next
+	}
+	e.next = nil
+	list.last = e
+	list.len = n
+}
+
 // Error returns a formatted list of violations as a string.
 func (list *ViolationList) Error() string {
 	if list == nil || list.len == 0 {
@@ -291,8 +375,19 @@ func (list *ViolationList) Filter(errs ...error) *ViolationList {
 }
 
 // AsError converts the list of violations to an error. This method correctly handles cases where
-// the list of violations is empty. It returns nil on an empty list, indicating that the validation was successful.
+// the list of violations is empty. It returns nil on an empty list or on the list without blocking
+// violations (see [Severity]), indicating that the validation was successful.
 func (list *ViolationList) AsError() error {
+	if !list.HasErrors() {
+		return nil
+	}
+
+	return list
+}
+
+// asError converts the non-empty list of violations to an error, including the non-blocking violations.
+// It is used to pass the violations from the nested validation.
+func (list *ViolationList) asError() error {
 	if list == nil || list.len == 0 {
 		return nil
 	}
@@ -300,6 +395,49 @@ func (list *ViolationList) AsError() error {
 	return list
 }
 
+// HasErrors returns true if the list contains at least one blocking violation (see [Severity.IsBlocking]).
+func (list *ViolationList) HasErrors() bool {
+	if list == nil {
+		return false
+	}
+	for e := list.first; e != nil; e = e.next {
+		if SeverityOf(e.violation).IsBlocking() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Errors returns a new list of blocking violations (see [Severity.IsBlocking]).
+func (list *ViolationList) Errors() *ViolationList {
+	return list.filterBySeverity(func(severity Severity) bool { return severity.IsBlocking() })
+}
+
+// Warnings returns a new list of non-blocking violations: warnings and notices.
+func (list *ViolationList) Warnings() *ViolationList {
+	return list.filterBySeverity(func(severity Severity) bool { return !severity.IsBlocking() })
+}
+
+// WithSeverity returns a new list of violations of the given levels.
+func (list *ViolationList) WithSeverity(severities ...Severity) *ViolationList {
+	return list.filterBySeverity(func(severity Severity) bool { return slices.Contains(severities, severity) })
+}
+
+func (list *ViolationList) filterBySeverity(match func(severity Severity) bool) *ViolationList {
+	filtered := &ViolationList{}
+	if list == nil {
+		return filtered
+	}
+	for e := list.first; e != nil; e = e.next {
+		if match(SeverityOf(e.violation)) {
+			filtered.Append(e.violation)
+		}
+	}
+
+	return filtered
+}
+
 // AsSlice converts underlying linked list into slice of [Violation].
 func (list *ViolationList) AsSlice() []Violation {
 	violations := make([]Violation, list.len)
@@ -335,6 +473,24 @@ func (list *ViolationList) MarshalJSON() ([]byte, error) {
 	return b.Bytes(), nil
 }
 
+// UnmarshalJSON decodes the list of violations from the JSON array. It accepts the format produced
+// by [ViolationList.MarshalJSON] and the lossless format of the [ViolationData]. Underlying errors
+// of the violations are resolved by their codes (see [ViolationData.Violation]).
+func (list *ViolationList) UnmarshalJSON(data []byte) error {
+	var violations []*ViolationData
+	if err := json.Unmarshal(data, &violations); err != nil {
+		return err
+	}
+
+	decoded, err := (&ViolationListData{Violations: violations}).ViolationList()
+	if err != nil {
+		return err
+	}
+	*list = *decoded
+
+	return nil
+}
+
 // Next returns the next element of the linked list.
 func (element *ViolationListElement) Next() *ViolationListElement {
 	return element.next
@@ -372,6 +528,44 @@ func (element *ViolationListElement) Parameters() []TemplateParameter {
 	return element.violation.Parameters()
 }
 
+// Severity returns the level of the underlying violation (see [SeverityOf]).
+func (element *ViolationListElement) Severity() Severity {
+	return SeverityOf(element.violation)
+}
+
+// Payload returns the payload of the underlying violation (see [PayloadOf]).
+func (element *ViolationListElement) Payload() any {
+	return PayloadOf(element.violation)
+}
+
+// PayloadOf returns the arbitrary data attached to the violation by the WithPayload method
+// of the constraint or the [ViolationBuilder]. It can be used to pass hints for the user interface
+// (for example, a link to the documentation or a remediation code) from the rule definition
+// to the API response. It returns nil if the violation does not provide the payload
+// (does not implement the Payload method).
+func PayloadOf(violation Violation) any {
+	if v, ok := violation.(interface{ Payload() any }); ok {
+		return v.Payload()
+	}
+
+	return nil
+}
+
+// UnsafeParameters returns the template parameters of the violation with the original values
+// of the sensitive parameters (see [TemplateParameter.Sensitive]). The values can contain secrets,
+// so they must not be logged or returned to the end-user. If the violation does not hold
+// the original values, then the result of [Violation.Parameters] is returned.
+func UnsafeParameters(violation Violation) []TemplateParameter {
+	if element, ok := violation.(*ViolationListElement); ok {
+		violation = element.violation
+	}
+	if v, ok := violation.(interface{ UnsafeParameters() []TemplateParameter }); ok {
+		return v.UnsafeParameters()
+	}
+
+	return violation.Parameters()
+}
+
 func (element *ViolationListElement) PropertyPath() *PropertyPath {
 	return element.violation.PropertyPath()
 }
@@ -439,8 +633,13 @@ type internalViolation struct {
 	err             error
 	message         string
 	messageTemplate string
+	pluralCount     int
 	parameters      []TemplateParameter
 	propertyPath    *PropertyPath
+	// unsafeParameters holds the original values of the sensitive parameters
+	unsafeParameters []TemplateParameter
+	severity         Severity
+	payload          any
 }
 
 func (v *internalViolation) Unwrap() error {
@@ -471,6 +670,18 @@ func (v *internalViolation) Message() string                 { return v.message
 func (v *internalViolation) MessageTemplate() string         { return v.messageTemplate }
 func (v *internalViolation) Parameters() []TemplateParameter { return v.parameters }
 func (v *internalViolation) PropertyPath() *PropertyPath     { return v.propertyPath }
+func (v *internalViolation) PluralCount() int                { return v.pluralCount }
+func (v *internalViolation) Severity() Severity              { return v.severity }
+func (v *internalViolation) Payload() any                    { return v.payload }
+
+// UnsafeParameters returns the template parameters with the original values of sensitive parameters.
+func (v *internalViolation) UnsafeParameters() []TemplateParameter {
+	if v.unsafeParameters != nil {
+		return v.unsafeParameters
+	}
+
+	return v.parameters
+}
 
 func (v *internalViolation) MarshalJSON() ([]byte, error) {
 	data := struct {
@@ -507,6 +718,19 @@ func (factory *BuiltinViolationFactory) CreateViolation(
 	parameters []TemplateParameter,
 	propertyPath *PropertyPath,
 	lang language.Tag,
+) Violation {
+	return factory.CreateDetailedViolation(err, messageTemplate, pluralCount, parameters, propertyPath, lang, ViolationDetails{})
+}
+
+// CreateDetailedViolation creates a new instance of [Violation] with the optional attributes.
+func (factory *BuiltinViolationFactory) CreateDetailedViolation(
+	err error,
+	messageTemplate string,
+	pluralCount int,
+	parameters []TemplateParameter,
+	propertyPath *PropertyPath,
+	lang language.Tag,
+	details ViolationDetails,
 ) Violation {
 	message := factory.translator.Translate(lang, messageTemplate, pluralCount)
 
@@ -516,13 +740,22 @@ func (factory *BuiltinViolationFactory) CreateViolation(
 		}
 	}
 
-	return &internalViolation{
+	violation := &internalViolation{
 		err:             err,
-		message:         renderMessage(message, parameters),
 		messageTemplate: messageTemplate,
-		parameters:      parameters,
+		pluralCount:     pluralCount,
 		propertyPath:    propertyPath,
+		severity:        details.Severity,
+		payload:         details.Payload,
 	}
+	var isMasked bool
+	violation.parameters, isMasked = maskParameters(parameters)
+	if isMasked {
+		violation.unsafeParameters = parameters
+	}
+	violation.message = renderMessage(message, violation.parameters)
+
+	return violation
 }
 
 // ViolationBuilder used to build an instance of a [Violation].
@@ -533,6 +766,8 @@ type ViolationBuilder struct {
 	parameters      []TemplateParameter
 	propertyPath    *PropertyPath
 	language        language.Tag
+	isSensitive     bool
+	details         ViolationDetails
 
 	violationFactory ViolationFactory
 }
@@ -607,16 +842,44 @@ func (b *ViolationBuilder) WithLanguage(tag language.Tag) *ViolationBuilder {
 	return b
 }
 
+// Sensitive marks all template parameters of the violation as sensitive (see [TemplateParameter.Sensitive]).
+func (b *ViolationBuilder) Sensitive() *ViolationBuilder {
+	b.isSensitive = true
+
+	return b
+}
+
+// WithSeverity sets the level of the violation (see [Severity]).
+func (b *ViolationBuilder) WithSeverity(severity Severity) *ViolationBuilder {
+	b.details.Severity = severity
+
+	return b
+}
+
+// WithPayload attaches the arbitrary data to the violation (see [PayloadOf]).
+func (b *ViolationBuilder) WithPayload(payload any) *ViolationBuilder {
+	b.details.Payload = payload
+
+	return b
+}
+
 // Create creates a new violation with given parameters and returns it.
 // Violation is created by calling the [ViolationFactory.CreateViolation].
 func (b *ViolationBuilder) Create() Violation {
-	return b.violationFactory.CreateViolation(
+	parameters := b.parameters
+	if b.isSensitive {
+		parameters = sensitiveParameters(parameters)
+	}
+
+	return createViolation(
+		b.violationFactory,
 		b.err,
 		b.messageTemplate,
 		b.pluralCount,
-		b.parameters,
+		parameters,
 		b.propertyPath,
 		b.language,
+		b.details,
 	)
 }
 
@@ -627,6 +890,7 @@ type ViolationListBuilder struct {
 
 	propertyPath *PropertyPath
 	language     language.Tag
+	isSensitive  bool
 }
 
 // ViolationListElementBuilder is used to build [Violation] that will be added into [ViolationList]
@@ -639,6 +903,7 @@ type ViolationListElementBuilder struct {
 	pluralCount     int
 	parameters      []TemplateParameter
 	propertyPath    *PropertyPath
+	details         ViolationDetails
 }
 
 // NewViolationListBuilder creates a new [ViolationListBuilder].
@@ -659,7 +924,7 @@ func (b *ViolationListBuilder) BuildViolation(err error, message string) *Violat
 // AddViolation can be used to quickly add a new violation using only code, message
 // and optional property path elements.
 func (b *ViolationListBuilder) AddViolation(err error, message string, path ...PropertyPathElement) *ViolationListBuilder {
-	return b.add(err, message, 0, nil, b.propertyPath.With(path...))
+	return b.add(err, message, 0, nil, b.propertyPath.With(path...), ViolationDetails{})
 }
 
 // SetPropertyPath resets a base property path of violated attributes.
@@ -701,14 +966,20 @@ func (b *ViolationListBuilder) add(
 	count int,
 	parameters []TemplateParameter,
 	path *PropertyPath,
+	details ViolationDetails,
 ) *ViolationListBuilder {
-	b.violations.Append(b.violationFactory.CreateViolation(
+	if b.isSensitive {
+		parameters = sensitiveParameters(parameters)
+	}
+	b.violations.Append(createViolation(
+		b.violationFactory,
 		err,
 		template,
 		count,
 		parameters,
 		path,
 		b.language,
+		details,
 	))
 
 	return b
@@ -721,6 +992,13 @@ func (b *ViolationListBuilder) WithLanguage(tag language.Tag) *ViolationListBuil
 	return b
 }
 
+// Sensitive marks all template parameters of the violations as sensitive (see [TemplateParameter.Sensitive]).
+func (b *ViolationListBuilder) Sensitive() *ViolationListBuilder {
+	b.isSensitive = true
+
+	return b
+}
+
 // WithParameters sets template parameters that can be injected into the violation message.
 func (b *ViolationListElementBuilder) WithParameters(parameters ...TemplateParameter) *ViolationListElementBuilder {
 	b.parameters = parameters
@@ -763,10 +1041,24 @@ func (b *ViolationListElementBuilder) WithPluralCount(pluralCount int) *Violatio
 	return b
 }
 
+// WithSeverity sets the level of the violation (see [Severity]).
+func (b *ViolationListElementBuilder) WithSeverity(severity Severity) *ViolationListElementBuilder {
+	b.details.Severity = severity
+
+	return b
+}
+
+// WithPayload attaches the arbitrary data to the violation (see [PayloadOf]).
+func (b *ViolationListElementBuilder) WithPayload(payload any) *ViolationListElementBuilder {
+	b.details.Payload = payload
+
+	return b
+}
+
 // Add creates a [Violation] and appends it into the end of the [ViolationList].
 // It returns a [ViolationListBuilder] to continue process of creating a [ViolationList].
 func (b *ViolationListElementBuilder) Add() *ViolationListBuilder {
-	return b.listBuilder.add(b.err, b.messageTemplate, b.pluralCount, b.parameters, b.propertyPath)
+	return b.listBuilder.add(b.err, b.messageTemplate, b.pluralCount, b.parameters, b.propertyPath, b.details)
 }
 
 func unwrapViolationList(err error) (*ViolationList, error) {
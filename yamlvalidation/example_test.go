@@ -0,0 +1,32 @@
+package yamlvalidation_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/muonsoft/validation"
+	_ "github.com/muonsoft/validation/it" // registers built-in constraints for rule sets
+	"github.com/muonsoft/validation/validator"
+	"github.com/muonsoft/validation/yamlvalidation"
+)
+
+func ExampleParseRuleSetYAML() {
+	ruleSet, err := yamlvalidation.ParseRuleSetYAML([]byte(`
+name: [notblank, length=1..50]
+email: [notblank, email]
+`))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = validator.Validate(context.Background(), ruleSet.For(map[string]any{"name": "John", "email": "invalid"}))
+
+	if violations, ok := validation.UnwrapViolations(err); ok {
+		for _, violation := range violations.All() {
+			fmt.Println(violation)
+		}
+	}
+	// Output:
+	// violation at "email": "This value is not a valid email address."
+}
@@ -0,0 +1,18 @@
+module github.com/muonsoft/validation/yamlvalidation
+
+go 1.24.0
+
+require (
+	github.com/muonsoft/validation v0.20.0
+	github.com/stretchr/testify v1.11.1
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/muonsoft/language v0.3.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/santhosh-tekuri/jsonschema/v6 v6.0.2 // indirect
+	golang.org/x/net v0.41.0 // indirect
+	golang.org/x/text v0.33.0 // indirect
+)
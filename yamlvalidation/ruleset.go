@@ -0,0 +1,27 @@
+// Package yamlvalidation contains a loader of declarative rule sets from YAML.
+//
+// The package is a separate module, so the YAML dependency is not added to the root module.
+package yamlvalidation
+
+import (
+	"fmt"
+
+	"github.com/muonsoft/validation"
+	"gopkg.in/yaml.v3"
+)
+
+// ParseRuleSetYAML parses the [validation.RuleSetSchema] from YAML into the [validation.RuleSet].
+// The document is a mapping of property paths to sequences of rules. For example:
+//
+//	title: [notblank, length=1..255]
+//	author.email:
+//	  - notblank
+//	  - email
+func ParseRuleSetYAML(data []byte) (*validation.RuleSet, error) {
+	var schema validation.RuleSetSchema
+	if err := yaml.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("parse rule set: %w", err)
+	}
+
+	return validation.NewRuleSet(schema)
+}
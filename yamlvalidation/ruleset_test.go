@@ -0,0 +1,94 @@
+package yamlvalidation_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/muonsoft/validation"
+	_ "github.com/muonsoft/validation/it" // registers built-in constraints for rule sets
+	"github.com/muonsoft/validation/validationtest"
+	"github.com/muonsoft/validation/validator"
+	"github.com/muonsoft/validation/yamlvalidation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const ruleSetYAML = `
+title: [notblank, length=1..10]
+rating: [between=0..5]
+author.email:
+  - notblank
+  - email
+chapters[1].Title: [length=1..5]
+`
+
+const invalidBook = `{
+	"title": "Too long title",
+	"rating": 6,
+	"author": {"email": "invalid"},
+	"chapters": [{"Title": "first"}, {"Title": "second"}]
+}`
+
+func TestParseRuleSetYAML_WhenMapIsInvalid_ExpectViolations(t *testing.T) {
+	ruleSet, err := yamlvalidation.ParseRuleSetYAML([]byte(ruleSetYAML))
+	require.NoError(t, err)
+
+	err = validator.Validate(context.Background(), ruleSet.For(decodeBook(t, invalidBook)))
+
+	validationtest.Assert(t, err).IsViolations(
+		validationtest.ViolationAttributes{Error: validation.ErrInvalidEmail, PropertyPath: "author.email"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooLong, PropertyPath: "chapters[1].Title"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotInRange, PropertyPath: "rating"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooLong, PropertyPath: "title"},
+	)
+}
+
+func TestParseRuleSetYAML_WhenSchemaEncodedToYAML_ExpectSameViolationsAsJSON(t *testing.T) {
+	schema := validation.RuleSetSchema{
+		"title":             {"notblank", "length=1..10"},
+		"rating":            {"between=0..5"},
+		"author.email":      {"notblank", "email"},
+		"chapters[1].Title": {"length=1..5"},
+	}
+	data, err := yaml.Marshal(schema)
+	require.NoError(t, err)
+	fromYAML, err := yamlvalidation.ParseRuleSetYAML(data)
+	require.NoError(t, err)
+	data, err = json.Marshal(schema)
+	require.NoError(t, err)
+	fromJSON, err := validation.ParseRuleSetJSON(data)
+	require.NoError(t, err)
+	book := decodeBook(t, invalidBook)
+
+	yamlErr := validator.Validate(context.Background(), fromYAML.For(book))
+	jsonErr := validator.Validate(context.Background(), fromJSON.For(book))
+
+	require.Error(t, yamlErr)
+	assert.Equal(t, jsonErr.Error(), yamlErr.Error())
+}
+
+func TestParseRuleSetYAML_WhenInvalidYAML_ExpectError(t *testing.T) {
+	ruleSet, err := yamlvalidation.ParseRuleSetYAML([]byte("title: notblank"))
+
+	assert.Nil(t, ruleSet)
+	assert.ErrorContains(t, err, "parse rule set")
+}
+
+func TestParseRuleSetYAML_WhenInvalidPath_ExpectConstraintError(t *testing.T) {
+	ruleSet, err := yamlvalidation.ParseRuleSetYAML([]byte("'title[': [notblank]"))
+
+	assert.Nil(t, ruleSet)
+	var constraintErr *validation.ConstraintError
+	assert.True(t, errors.As(err, &constraintErr))
+}
+
+func decodeBook(t *testing.T, data string) map[string]any {
+	t.Helper()
+	var book map[string]any
+	require.NoError(t, json.Unmarshal([]byte(data), &book))
+
+	return book
+}